@@ -0,0 +1,280 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package audit
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strings"
+	"sync"
+
+	btcutil "github.com/FactomProject/btcutilecc"
+	"github.com/lukaz17/cryptotool-go/hasher"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	// Maximum distance from a weak seed for a key to be flagged. Generators like Profanity
+	// start from a weak seed and add small increments to search for vanity addresses.
+	StepRange = 1 << 20
+
+	// Keys below 2^64 or above n - 2^64 are small scalars. A random key falls in this range
+	// with probability 2^-191.
+	smallScalarBits = 64
+	// A random key has at most 32 or at least 224 bits set with probability below 2^-120.
+	minHammingWeight = 32
+	// Number of steps from each weak seed covered by address lookups.
+	addressStepRange = 16
+)
+
+//go:embed brainwallets.txt
+var brainwalletList string
+
+// A WeaknessKind is the class of weak generation a key is produced by.
+type WeaknessKind int
+
+const (
+	// Key is close to 0 or to the curve order.
+	SmallScalar WeaknessKind = iota
+	// Key is close to a repeated byte pattern or has very few bits set or unset.
+	LowEntropyPattern
+	// Key is close to the hash of a well-known brainwallet phrase.
+	Brainwallet
+)
+
+// Returns the name of weakness kind.
+func (k WeaknessKind) String() string {
+	switch k {
+	case SmallScalar:
+		return "small-scalar"
+	case LowEntropyPattern:
+		return "low-entropy-pattern"
+	case Brainwallet:
+		return "brainwallet"
+	}
+	return fmt.Sprintf("WeaknessKind(%d)", int(k))
+}
+
+// A Finding describes one weakness of a key.
+type Finding struct {
+	Kind   WeaknessKind
+	Detail string
+}
+
+// A Report contains all findings of an audit.
+type Report struct {
+	Findings []Finding
+}
+
+// Returns true if at least one weakness is found.
+func (r *Report) IsWeak() bool {
+	return len(r.Findings) > 0
+}
+
+// Returns findings in human-readable format.
+func (r *Report) String() string {
+	if !r.IsWeak() {
+		return "no weakness found"
+	}
+	lines := make([]string, len(r.Findings))
+	for i, finding := range r.Findings {
+		lines[i] = fmt.Sprintf("%s: %s", finding.Kind, finding.Detail)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Returns the audit report of the private key of keypair.
+func AuditKeypair(keypair *keymngr.Secp256k1Keypair) *Report {
+	privateKey := keypair.PrivateKey()
+	defer secmem.Wipe(privateKey)
+	return AuditPrivateKey(privateKey)
+}
+
+// Returns the audit report of a 32-byte private key.
+func AuditPrivateKey(privateKey stdx.Bytes) *Report {
+	report := &Report{}
+	n := btcutil.Secp256k1().Params().N
+	key := new(big.Int).SetBytes(privateKey)
+	if key.Sign() == 0 || key.Cmp(n) >= 0 {
+		report.Findings = append(report.Findings, Finding{SmallScalar, "key is out of range [1, n-1]"})
+		return report
+	}
+
+	negated := new(big.Int).Sub(n, key)
+	if key.BitLen() <= smallScalarBits {
+		report.Findings = append(report.Findings, Finding{SmallScalar, fmt.Sprintf("key is %s", key)})
+	} else if negated.BitLen() <= smallScalarBits {
+		report.Findings = append(report.Findings, Finding{SmallScalar, fmt.Sprintf("key is n - %s", negated)})
+	}
+
+	if detail, ok := repeatedPattern(key); ok {
+		report.Findings = append(report.Findings, Finding{LowEntropyPattern, detail})
+	}
+	weight := 0
+	for _, word := range key.Bits() {
+		weight += bits.OnesCount64(uint64(word))
+	}
+	if weight <= minHammingWeight || weight >= 256-minHammingWeight {
+		report.Findings = append(report.Findings, Finding{LowEntropyPattern, fmt.Sprintf("key has %d of 256 bits set", weight)})
+	}
+
+	for _, seed := range brainwalletSeeds() {
+		distance := new(big.Int).Sub(key, seed.key)
+		if distance.CmpAbs(big.NewInt(StepRange)) <= 0 {
+			detail := fmt.Sprintf("key is %s of phrase %q%s", seed.hash, seed.phrase, stepSuffix(distance.Int64()))
+			report.Findings = append(report.Findings, Finding{Brainwallet, detail})
+		}
+	}
+	return report
+}
+
+// Returns the audit report of an Ethereum address. Unlike AuditPrivateKey, only a limited
+// number of steps from each weak seed can be checked because the key is unknown.
+func AuditAddress(address string) (*Report, error) {
+	address = strings.ToLower(strings.TrimPrefix(address, "0x"))
+	if _, err := hex.DecodeString(address); err != nil || len(address) != 40 {
+		return nil, fmt.Errorf("invalid address %s", address)
+	}
+	report := &Report{}
+	if finding, ok := weakAddresses()[address]; ok {
+		report.Findings = append(report.Findings, finding)
+	}
+	return report, nil
+}
+
+// Returns a description if key is within StepRange of a value made of a repeated
+// 1, 2, 4 or 8-byte pattern, e.g. 0x4646...46 or 0xdeadbeef...deadbeef.
+func repeatedPattern(key *big.Int) (string, bool) {
+	maxDistance := big.NewInt(StepRange)
+	for _, candidate := range []*big.Int{key, new(big.Int).Add(key, maxDistance), new(big.Int).Sub(key, maxDistance)} {
+		if candidate.Sign() <= 0 {
+			continue
+		}
+		buf := make([]byte, 32)
+		candidate.FillBytes(buf)
+		for _, length := range []int{1, 2, 4, 8} {
+			repeated := make([]byte, 32)
+			for i := range repeated {
+				repeated[i] = buf[i%length]
+			}
+			value := new(big.Int).SetBytes(repeated)
+			if value.Sign() == 0 {
+				// Keys close to zero are reported as small scalars.
+				continue
+			}
+			distance := new(big.Int).Sub(key, value)
+			if distance.CmpAbs(maxDistance) <= 0 {
+				return fmt.Sprintf("key is repeated pattern 0x%x%s", repeated[:length], stepSuffix(distance.Int64())), true
+			}
+		}
+	}
+	return "", false
+}
+
+type brainwalletSeed struct {
+	phrase string
+	hash   string
+	key    *big.Int
+}
+
+var (
+	brainwalletOnce  sync.Once
+	brainwalletCache []brainwalletSeed
+	addressOnce      sync.Once
+	addressCache     map[string]Finding
+)
+
+// Returns private keys derived from embedded brainwallet phrases using SHA-256
+// like Bitcoin brainwallets and Keccak-256 like early Ethereum brainwallets.
+func brainwalletSeeds() []brainwalletSeed {
+	brainwalletOnce.Do(func() {
+		phrases := append([]string{""}, strings.Split(strings.TrimSpace(brainwalletList), "\n")...)
+		for _, phrase := range phrases {
+			phrase = strings.TrimRight(phrase, "\r")
+			brainwalletCache = append(brainwalletCache,
+				brainwalletSeed{phrase, "sha256", new(big.Int).SetBytes(hasher.Sha256([]byte(phrase)))},
+				brainwalletSeed{phrase, "keccak256", new(big.Int).SetBytes(hasher.Keccak256([]byte(phrase)))},
+			)
+		}
+	})
+	return brainwalletCache
+}
+
+// Returns addresses of keys within addressStepRange steps of every weak seed.
+func weakAddresses() map[string]Finding {
+	addressOnce.Do(func() {
+		curve := btcutil.Secp256k1()
+		params := curve.Params()
+		addressCache = map[string]Finding{}
+		pubkey := make([]byte, 64)
+		// Walk from point (x, y) with point additions which are much cheaper than scalar multiplications.
+		walk := func(x, y *big.Int, kind WeaknessKind, describe func(step int) string) {
+			for step := 0; step < addressStepRange; step++ {
+				x.FillBytes(pubkey[:32])
+				y.FillBytes(pubkey[32:])
+				address := hex.EncodeToString(hasher.Keccak256(pubkey)[12:])
+				if _, ok := addressCache[address]; !ok {
+					addressCache[address] = Finding{kind, describe(step)}
+				}
+				if step+1 < addressStepRange {
+					x, y = addPoints(x, y, params.Gx, params.Gy)
+				}
+			}
+		}
+		walk(params.Gx, params.Gy, SmallScalar, func(step int) string {
+			return fmt.Sprintf("key is %d", step+1)
+		})
+		x, y := curve.ScalarBaseMult(new(big.Int).Sub(params.N, big.NewInt(addressStepRange)).Bytes())
+		walk(x, y, SmallScalar, func(step int) string {
+			return fmt.Sprintf("key is n - %d", addressStepRange-step)
+		})
+		// Repeated byte b is b * 0x0101...01, so each pattern is one addition away from the previous one.
+		unitX, unitY := curve.ScalarBaseMult(bytes.Repeat([]byte{0x01}, 32))
+		x, y = unitX, unitY
+		for b := 1; b < 0xff; b++ {
+			pattern := b
+			walk(x, y, LowEntropyPattern, func(step int) string {
+				return fmt.Sprintf("key is repeated pattern 0x%02x%s", pattern, stepSuffix(int64(step)))
+			})
+			x, y = addPoints(x, y, unitX, unitY)
+		}
+		for _, seed := range brainwalletSeeds() {
+			if seed.key.Sign() == 0 || seed.key.Cmp(params.N) >= 0 {
+				continue
+			}
+			seed := seed
+			x, y := curve.ScalarBaseMult(seed.key.Bytes())
+			walk(x, y, Brainwallet, func(step int) string {
+				return fmt.Sprintf("key is %s of phrase %q%s", seed.hash, seed.phrase, stepSuffix(int64(step)))
+			})
+		}
+	})
+	return addressCache
+}
+
+// Returns the sum of two points. Add of the curve does not handle equal points.
+func addPoints(x1, y1, x2, y2 *big.Int) (*big.Int, *big.Int) {
+	curve := btcutil.Secp256k1()
+	if x1.Cmp(x2) == 0 && y1.Cmp(y2) == 0 {
+		return curve.Double(x1, y1)
+	}
+	return curve.Add(x1, y1, x2, y2)
+}
+
+// Returns the distance from a weak seed in format " +5", or empty string if distance is zero.
+func stepSuffix(distance int64) string {
+	if distance == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" %+d", distance)
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package audit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/lukaz17/cryptotool-go/hasher"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+func TestAuditPrivateKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		privateKey string
+		kinds      []WeaknessKind
+	}{
+		{"one", "0000000000000000000000000000000000000000000000000000000000000001", []WeaknessKind{SmallScalar, LowEntropyPattern}},
+		{"32_bit_seed", "00000000000000000000000000000000000000000000000000000000deadbeef", []WeaknessKind{SmallScalar, LowEntropyPattern}},
+		{"n_minus_one", "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140", []WeaknessKind{SmallScalar}},
+		{"repeated_byte", "4646464646464646464646464646464646464646464646464646464646464646", []WeaknessKind{LowEntropyPattern}},
+		{"repeated_byte_stepped", "46464646464646464646464646464646464646464646464646464646464a0000", []WeaknessKind{LowEntropyPattern}},
+		{"repeated_word", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef", []WeaknessKind{LowEntropyPattern}},
+		{"brainwallet_sha256", hex.EncodeToString(hasher.Sha256([]byte("correct horse battery staple"))), []WeaknessKind{Brainwallet}},
+		{"brainwallet_empty", hex.EncodeToString(hasher.Sha256(nil)), []WeaknessKind{Brainwallet}},
+		{"random", "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKey, _ := hex.DecodeString(tt.privateKey)
+			report := AuditKeypair(keymngr.NewSecp256k1Keypair(privateKey))
+			if len(report.Findings) != len(tt.kinds) {
+				t.Fatalf("invalid findings. expected %v actual %v", tt.kinds, report)
+			}
+			for i, kind := range tt.kinds {
+				if report.Findings[i].Kind != kind {
+					t.Errorf("invalid finding. expected %s actual %s", kind, report.Findings[i].Kind)
+				}
+			}
+		})
+	}
+}
+
+func TestAuditPrivateKey_SteppedBrainwallet(t *testing.T) {
+	key := new(big.Int).SetBytes(hasher.Keccak256([]byte("password")))
+	key.Add(key, big.NewInt(12345))
+	privateKey := make([]byte, 32)
+	key.FillBytes(privateKey)
+	report := AuditPrivateKey(privateKey)
+	if !report.IsWeak() || report.Findings[0].Kind != Brainwallet {
+		t.Fatalf("stepped brainwallet key must be flagged. actual %v", report)
+	}
+	expected := `key is keccak256 of phrase "password" +12345`
+	if report.Findings[0].Detail != expected {
+		t.Errorf("invalid detail. expected %s actual %s", expected, report.Findings[0].Detail)
+	}
+}
+
+func TestAuditPrivateKey_Random(t *testing.T) {
+	privateKey := make([]byte, 32)
+	for i := 0; i < 100; i++ {
+		rand.Read(privateKey)
+		if report := AuditPrivateKey(privateKey); report.IsWeak() {
+			t.Errorf("random key %x must not be flagged. actual %v", privateKey, report)
+		}
+	}
+}
+
+func TestAuditAddress(t *testing.T) {
+	brainwallet := keymngr.NewEthereumAccount(keymngr.NewSecp256k1Keypair(hasher.Sha256([]byte("satoshi nakamoto"))))
+	tests := []struct {
+		name    string
+		address string
+		isWeak  bool
+	}{
+		{"key_one", "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf", true},
+		{"key_two", "0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF", true},
+		{"repeated_byte", "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F", true},
+		{"brainwallet", brainwallet.AddressStr(), true},
+		{"random", "0x90F8bf6A479f320ead074411a4B0e7944Ea8c9C1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := AuditAddress(tt.address)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if report.IsWeak() != tt.isWeak {
+				t.Errorf("invalid result. expected %v actual %v", tt.isWeak, report)
+			}
+		})
+	}
+	if _, err := AuditAddress("0x1234"); err == nil {
+		t.Errorf("expected error for invalid address")
+	}
+}
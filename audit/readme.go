@@ -0,0 +1,13 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package audit provides APIs to detect private keys produced by weak generation, such as
+small scalars, keys stepped from low-entropy seeds like in the Profanity vulnerability and
+well-known brainwallet phrases. Such keys are routinely swept by attackers and must never
+receive funds.
+*/
+package audit
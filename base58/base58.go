@@ -0,0 +1,127 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package base58
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/lukaz17/cryptotool-go/hasher"
+)
+
+const (
+	// Bitcoin alphabet, without 0, O, I and l to avoid visual ambiguity.
+	Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	checksumLength = 4
+)
+
+// ErrChecksum is returned when Base58Check checksum does not match.
+var ErrChecksum = errors.New("invalid checksum")
+
+var decodeMap [256]int8
+
+func init() {
+	for i := range decodeMap {
+		decodeMap[i] = -1
+	}
+	for i := 0; i < len(Alphabet); i++ {
+		decodeMap[Alphabet[i]] = int8(i)
+	}
+}
+
+// Returns Base58 encoding of data. Each leading zero byte is encoded as '1'.
+func Encode(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+	// log(256) / log(58) is about 1.37, so 138% is enough.
+	digits := make([]byte, (len(data)-zeros)*138/100+1)
+	length := 0
+	for _, b := range data[zeros:] {
+		carry := int(b)
+		i := 0
+		for j := len(digits) - 1; (carry != 0 || i < length) && j >= 0; j-- {
+			carry += 256 * int(digits[j])
+			digits[j] = byte(carry % 58)
+			carry /= 58
+			i++
+		}
+		length = i
+	}
+	digits = digits[len(digits)-length:]
+	result := make([]byte, zeros+len(digits))
+	for i := 0; i < zeros; i++ {
+		result[i] = Alphabet[0]
+	}
+	for i, d := range digits {
+		result[zeros+i] = Alphabet[d]
+	}
+	return string(result)
+}
+
+// Returns the data of Base58 encoded str.
+func Decode(str string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(str) && str[zeros] == Alphabet[0] {
+		zeros++
+	}
+	// log(58) / log(256) is about 0.733, so 74% is enough.
+	values := make([]byte, (len(str)-zeros)*733/1000+1)
+	length := 0
+	for i := zeros; i < len(str); i++ {
+		carry := int(decodeMap[str[i]])
+		if carry < 0 {
+			return nil, fmt.Errorf("invalid character %q at position %d", str[i], i)
+		}
+		k := 0
+		for j := len(values) - 1; (carry != 0 || k < length) && j >= 0; j-- {
+			carry += 58 * int(values[j])
+			values[j] = byte(carry % 256)
+			carry /= 256
+			k++
+		}
+		length = k
+	}
+	values = values[len(values)-length:]
+	return append(make([]byte, zeros), values...), nil
+}
+
+// Returns Base58 encoding of data followed by the first 4 bytes of its double SHA-256.
+// Callers prepend version bytes to data, e.g. 0x00 for Bitcoin P2PKH addresses.
+func CheckEncode(data []byte) string {
+	checksum := hasher.DoubleSha256(data)[:checksumLength]
+	payload := make([]byte, 0, len(data)+checksumLength)
+	payload = append(payload, data...)
+	payload = append(payload, checksum...)
+	return Encode(payload)
+}
+
+// Returns the data of Base58Check encoded str without checksum.
+// ErrChecksum is returned if the checksum does not match.
+func CheckDecode(str string) ([]byte, error) {
+	payload, err := Decode(str)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) < checksumLength {
+		return nil, errors.New("data is too short")
+	}
+	data := payload[:len(payload)-checksumLength]
+	checksum := hasher.DoubleSha256(data)[:checksumLength]
+	if !bytes.Equal(checksum, payload[len(payload)-checksumLength:]) {
+		return nil, ErrChecksum
+	}
+	return data, nil
+}
+
+// Returns true if c is a character of the Base58 alphabet.
+func IsValidChar(c byte) bool {
+	return decodeMap[c] >= 0
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package base58
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	// Test cases are taken from Bitcoin Core base58_encode_decode.json
+	tests := []struct {
+		name    string
+		hex     string
+		encoded string
+	}{
+		{"empty", "", ""},
+		{"single_byte", "61", "2g"},
+		{"three_bytes", "626262", "a3gV"},
+		{"text", "73696d706c792061206c6f6e6720737472696e67", "2cFupjhnEsSn59qHXstmK2ffpLv2"},
+		{"leading_zero", "00eb15231dfceb60925886b67d065299925915aeb172c06647", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+		{"five_bytes", "516b6fcd0f", "ABnLTmg"},
+		{"nine_bytes", "bf4f89001e670274dd", "3SEo3LWLoPntC"},
+		{"ten_bytes", "ecac89cad93923c02321", "EJDM8drfXA6uyA"},
+		{"all_zeros", "00000000000000000000", "1111111111"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, _ := hex.DecodeString(tt.hex)
+			encoded := Encode(data)
+			if encoded != tt.encoded {
+				t.Errorf("invalid encoding. expected %s actual %s", tt.encoded, encoded)
+			}
+			decoded, err := Decode(tt.encoded)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if hex.EncodeToString(decoded) != tt.hex {
+				t.Errorf("invalid decoding. expected %s actual %x", tt.hex, decoded)
+			}
+		})
+	}
+}
+
+func TestCheckDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+		hex     string
+		isValid bool
+	}{
+		{"p2pkh", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "00751e76e8199196d454941c45d1b3a323f1433bd6", true},
+		{"wrong_checksum", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ", "", false},
+		{"invalid_char", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAM0", "", false},
+		{"too_short", "1", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := CheckDecode(tt.encoded)
+			if (err == nil) != tt.isValid {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if tt.isValid {
+				if hex.EncodeToString(data) != tt.hex {
+					t.Errorf("invalid data. expected %s actual %x", tt.hex, data)
+				}
+				if CheckEncode(data) != tt.encoded {
+					t.Errorf("invalid encoding. expected %s actual %s", tt.encoded, CheckEncode(data))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package base58 provides APIs to encode and decode data using Base58 with Bitcoin alphabet,
+and Base58Check which appends a 4-byte checksum to detect typos.
+*/
+package base58
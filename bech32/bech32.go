@@ -0,0 +1,237 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package bech32
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// Characters of Bech32 data part, without 1, b, i and o.
+	Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	checksumLength = 6
+	maxLength      = 90
+)
+
+// A Variant selects the checksum constant of the encoding.
+type Variant int
+
+const (
+	// Bech32 defined in BIP-173, used by segwit version 0 addresses.
+	Bech32 Variant = iota
+	// Bech32m defined in BIP-350, used by segwit version 1+ addresses.
+	Bech32m
+)
+
+func (v Variant) constant() uint32 {
+	if v == Bech32m {
+		return 0x2bc830a3
+	}
+	return 1
+}
+
+var charsetRev [128]int8
+
+func init() {
+	for i := range charsetRev {
+		charsetRev[i] = -1
+	}
+	for i := 0; i < len(Charset); i++ {
+		charsetRev[Charset[i]] = int8(i)
+	}
+}
+
+// Returns Bech32 string of human-readable part hrp and data in 5-bit groups.
+func Encode(hrp string, data []byte, variant Variant) (string, error) {
+	if len(hrp)+1+len(data)+checksumLength > maxLength {
+		return "", errors.New("data is too long")
+	}
+	hrp = strings.ToLower(hrp)
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, d := range data {
+		if d >= 32 {
+			return "", fmt.Errorf("invalid 5-bit value %d", d)
+		}
+		sb.WriteByte(Charset[d])
+	}
+	for _, d := range createChecksum(hrp, data, variant) {
+		sb.WriteByte(Charset[d])
+	}
+	return sb.String(), nil
+}
+
+// Returns the human-readable part, data in 5-bit groups and variant of Bech32 string str.
+// Mixed case strings are rejected.
+func Decode(str string) (string, []byte, Variant, error) {
+	if len(str) > maxLength {
+		return "", nil, 0, errors.New("string is too long")
+	}
+	lower := strings.ToLower(str)
+	if lower != str && strings.ToUpper(str) != str {
+		return "", nil, 0, errors.New("mixed case string")
+	}
+	separator := strings.LastIndexByte(lower, '1')
+	if separator < 1 || separator+checksumLength+1 > len(lower) {
+		return "", nil, 0, errors.New("invalid separator position")
+	}
+	hrp := lower[:separator]
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, 0, fmt.Errorf("invalid character %q in human-readable part", hrp[i])
+		}
+	}
+	data := make([]byte, 0, len(lower)-separator-1)
+	for i := separator + 1; i < len(lower); i++ {
+		c := lower[i]
+		if c >= 128 || charsetRev[c] < 0 {
+			return "", nil, 0, fmt.Errorf("invalid character %q at position %d", c, i)
+		}
+		data = append(data, byte(charsetRev[c]))
+	}
+	var variant Variant
+	switch polymod(append(expandHrp(hrp), data...)) {
+	case Bech32.constant():
+		variant = Bech32
+	case Bech32m.constant():
+		variant = Bech32m
+	default:
+		return "", nil, 0, errors.New("invalid checksum")
+	}
+	return hrp, data[:len(data)-checksumLength], variant, nil
+}
+
+// Returns data regrouped from fromBits-bit groups to toBits-bit groups.
+// If pad is false, leftover bits must be zero padding shorter than fromBits.
+func ConvertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var acc uint32
+	var bits uint
+	maxValue := uint32(1)<<toBits - 1
+	result := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, value := range data {
+		if uint32(value)>>fromBits != 0 {
+			return nil, fmt.Errorf("invalid %d-bit value %d", fromBits, value)
+		}
+		acc = acc<<fromBits | uint32(value)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(acc>>bits&maxValue))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(acc<<(toBits-bits)&maxValue))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxValue != 0 {
+		return nil, errors.New("invalid padding")
+	}
+	return result, nil
+}
+
+// Returns segwit address of witness program with version. Version 0 uses Bech32,
+// later versions use Bech32m as required by BIP-350.
+func EncodeSegwitAddress(hrp string, version byte, program []byte) (string, error) {
+	if err := validateWitness(version, program); err != nil {
+		return "", err
+	}
+	data, _ := ConvertBits(program, 8, 5, true)
+	variant := Bech32
+	if version > 0 {
+		variant = Bech32m
+	}
+	return Encode(hrp, append([]byte{version}, data...), variant)
+}
+
+// Returns witness version and program of segwit address with human-readable part hrp.
+func DecodeSegwitAddress(hrp, address string) (byte, []byte, error) {
+	decodedHrp, data, variant, err := Decode(address)
+	if err != nil {
+		return 0, nil, err
+	}
+	if decodedHrp != strings.ToLower(hrp) {
+		return 0, nil, fmt.Errorf("invalid human-readable part %s", decodedHrp)
+	}
+	if len(data) == 0 {
+		return 0, nil, errors.New("empty data")
+	}
+	version := data[0]
+	if (version == 0 && variant != Bech32) || (version > 0 && variant != Bech32m) {
+		return 0, nil, errors.New("invalid checksum variant for witness version")
+	}
+	program, err := ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := validateWitness(version, program); err != nil {
+		return 0, nil, err
+	}
+	return version, program, nil
+}
+
+// Returns true if c is a character of Bech32 data part, case-insensitive.
+func IsValidChar(c byte) bool {
+	if c >= 'A' && c <= 'Z' {
+		c += 'a' - 'A'
+	}
+	return c < 128 && charsetRev[c] >= 0
+}
+
+func validateWitness(version byte, program []byte) error {
+	if version > 16 {
+		return fmt.Errorf("invalid witness version %d", version)
+	}
+	if len(program) < 2 || len(program) > 40 {
+		return fmt.Errorf("invalid witness program length %d", len(program))
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return fmt.Errorf("invalid witness program length %d for version 0", len(program))
+	}
+	return nil
+}
+
+func createChecksum(hrp string, data []byte, variant Variant) []byte {
+	values := append(expandHrp(hrp), data...)
+	values = append(values, make([]byte, checksumLength)...)
+	mod := polymod(values) ^ variant.constant()
+	checksum := make([]byte, checksumLength)
+	for i := range checksum {
+		checksum[i] = byte(mod >> (5 * (5 - i)) & 31)
+	}
+	return checksum
+}
+
+func expandHrp(hrp string) []byte {
+	result := make([]byte, 0, 2*len(hrp)+1)
+	for i := 0; i < len(hrp); i++ {
+		result = append(result, hrp[i]>>5)
+	}
+	result = append(result, 0)
+	for i := 0; i < len(hrp); i++ {
+		result = append(result, hrp[i]&31)
+	}
+	return result
+}
+
+func polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package bech32
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSegwitAddress(t *testing.T) {
+	// Test cases are taken from BIP-350
+	tests := []struct {
+		name    string
+		hrp     string
+		address string
+		version byte
+		program string
+	}{
+		{"p2wpkh", "bc", "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", 0, "751e76e8199196d454941c45d1b3a323f1433bd6"},
+		{"p2wsh", "tb", "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", 0, "1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"},
+		{"v1_40_bytes", "bc", "bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kt5nd6y", 1, "751e76e8199196d454941c45d1b3a323f1433bd6751e76e8199196d454941c45d1b3a323f1433bd6"},
+		{"v16", "bc", "BC1SW50QGDZ25J", 16, "751e"},
+		{"v2", "bc", "bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs", 2, "751e76e8199196d454941c45d1b3a323"},
+		{"p2tr", "bc", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", 1, "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, program, err := DecodeSegwitAddress(tt.hrp, tt.address)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if version != tt.version || hex.EncodeToString(program) != tt.program {
+				t.Errorf("invalid witness. expected %d %s actual %d %x", tt.version, tt.program, version, program)
+			}
+			address, err := EncodeSegwitAddress(tt.hrp, version, program)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if address != strings.ToLower(tt.address) {
+				t.Errorf("invalid address. expected %s actual %s", strings.ToLower(tt.address), address)
+			}
+		})
+	}
+}
+
+func TestDecodeSegwitAddress_Invalid(t *testing.T) {
+	// Test cases are taken from BIP-350
+	tests := []struct {
+		name    string
+		hrp     string
+		address string
+	}{
+		{"bech32_for_v1", "bc", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd"},
+		{"bech32m_for_v0", "bc", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh"},
+		{"invalid_version", "bc", "BC130XLXVLHEMJA6C4DQV22UAPCTQUPFHLXM9H8Z3K2E72Q4K9HCZ7VQ7ZWS8R"},
+		{"program_too_short", "bc", "bc1pw5dgrnzv"},
+		{"empty_data", "bc", "bc1gmk9yu"},
+		{"mixed_case", "tb", "tb1z0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq47Zagq"},
+		{"wrong_hrp", "tb", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := DecodeSegwitAddress(tt.hrp, tt.address); err == nil {
+				t.Errorf("expected error for %s", tt.address)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package bech32 provides APIs to encode and decode data using Bech32 (BIP-173) and
+Bech32m (BIP-350), and to build Bitcoin segregated witness addresses.
+*/
+package bech32
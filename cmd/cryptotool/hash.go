@@ -0,0 +1,225 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/lukaz17/cryptotool-go/hasher"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+var (
+	// Line of a checksum file in BSD format: ALGORITHM (name) = digest.
+	taggedChecksumLine = regexp.MustCompile(`^(\S+) \((.*)\) = ([0-9a-fA-F]+)$`)
+	// Line of a checksum file in sha256sum format: digest, a space, a space or '*', then name.
+	checksumLine = regexp.MustCompile(`^([0-9a-fA-F]+) [ *](.*)$`)
+)
+
+func runHash(args []string) error {
+	flags := newFlagSet("hash")
+	algorithmNames := flags.String("a", "sha256", "comma-separated algorithms: "+strings.Join(hasher.Names(), ", ")+", blake2b-<bits>, blake2s-<bits> or tagged:<tag>")
+	text := flags.String("s", "", "hash the UTF-8 string instead of files")
+	hexData := flags.String("x", "", "hash the hex string instead of files")
+	check := flags.Bool("c", false, "read digests from the files and check them")
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: cryptotool hash [flags] [file ...]")
+		fmt.Fprintln(os.Stderr, "With no file, or when file is -, read standard input.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	algorithms := []*hasher.Algorithm{}
+	for _, name := range strings.Split(*algorithmNames, ",") {
+		algorithm, err := hasher.Get(name)
+		if err != nil {
+			return err
+		}
+		algorithms = append(algorithms, algorithm)
+	}
+	// Empty -s hashes the empty string, so check which flags were given rather than their values.
+	set := map[string]bool{}
+	flags.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	switch {
+	case set["s"] && set["x"]:
+		return errors.New("only one of -s and -x can be used")
+	case (set["s"] || set["x"]) && *check:
+		return errors.New("-c cannot be used with -s or -x")
+	case (set["s"] || set["x"]) && flags.NArg() > 0:
+		return errors.New("files cannot be used with -s or -x")
+	}
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	if *check {
+		return checkDigests(files, algorithms)
+	}
+
+	var data []byte
+	switch {
+	case set["s"]:
+		data = []byte(*text)
+	case set["x"]:
+		var err error
+		if data, err = hex.DecodeString(strings.TrimPrefix(*hexData, "0x")); err != nil {
+			return fmt.Errorf("invalid hex string %s", *hexData)
+		}
+	}
+	if set["s"] || set["x"] {
+		digests, err := hasher.SumReader(bytes.NewReader(data), algorithms...)
+		if err != nil {
+			return err
+		}
+		printDigests(os.Stdout, "-", algorithms, digests)
+		return nil
+	}
+	failed := 0
+	for _, file := range files {
+		digests, err := sumFile(file, algorithms)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", file, err)
+			failed++
+			continue
+		}
+		printDigests(os.Stdout, file, algorithms, digests)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files could not be read", failed, len(files))
+	}
+	return nil
+}
+
+// Returns digests of file, or standard input if file is -, in one pass.
+func sumFile(file string, algorithms []*hasher.Algorithm) ([]stdx.Bytes, error) {
+	if file == "-" {
+		return hasher.SumReader(stdin, algorithms...)
+	}
+	return hasher.SumFile(file, algorithms...)
+}
+
+// Prints digests in sha256sum format for one algorithm, or BSD format for multiple algorithms.
+func printDigests(w io.Writer, name string, algorithms []*hasher.Algorithm, digests []stdx.Bytes) {
+	if len(algorithms) == 1 {
+		fmt.Fprintf(w, "%s  %s\n", digests[0].HexStr(), name)
+		return
+	}
+	for i, algorithm := range algorithms {
+		label := algorithm.Name
+		// Tags of tagged hashes are case-sensitive.
+		if !strings.HasPrefix(label, "tagged:") {
+			label = strings.ToUpper(label)
+		}
+		fmt.Fprintf(w, "%s (%s) = %s\n", label, name, digests[i].HexStr())
+	}
+}
+
+// Checks digests listed in checksum files, each line is in the format printed by printDigests.
+// Lines in sha256sum format use the only algorithm of algorithms.
+func checkDigests(checksumFiles []string, algorithms []*hasher.Algorithm) error {
+	total, failed := 0, 0
+	for _, checksumFile := range checksumFiles {
+		fileTotal, fileFailed, err := checkDigestFile(checksumFile, algorithms)
+		if err != nil {
+			return err
+		}
+		total += fileTotal
+		failed += fileFailed
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d computed digests did not match", failed, total)
+	}
+	return nil
+}
+
+// A checkEntry is a digest listed in a checksum file.
+type checkEntry struct {
+	algorithm *hasher.Algorithm
+	expected  string
+}
+
+// Verifies every line of checksumFile, "-" for stdin. The file is closed before returning.
+// Each listed file is read once for all of its algorithms, results are printed in order of
+// first appearance of the file. Returns the number of computed digests and the number of mismatches.
+func checkDigestFile(checksumFile string, algorithms []*hasher.Algorithm) (int, int, error) {
+	var r io.Reader = stdin
+	if checksumFile != "-" {
+		file, err := os.Open(checksumFile)
+		if err != nil {
+			return 0, 0, err
+		}
+		defer file.Close()
+		r = file
+	}
+	names := []string{}
+	entries := map[string][]*checkEntry{}
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var algorithm *hasher.Algorithm
+		var name, expected string
+		if match := taggedChecksumLine.FindStringSubmatch(line); match != nil {
+			var err error
+			if algorithm, err = hasher.Get(match[1]); err != nil {
+				return 0, 0, fmt.Errorf("%s:%d: %v", checksumFile, lineNumber, err)
+			}
+			name, expected = match[2], match[3]
+		} else if match := checksumLine.FindStringSubmatch(line); match != nil {
+			if len(algorithms) != 1 {
+				return 0, 0, fmt.Errorf("%s:%d: exactly one algorithm is required for lines without algorithm", checksumFile, lineNumber)
+			}
+			algorithm, name, expected = algorithms[0], match[2], match[1]
+		} else {
+			return 0, 0, fmt.Errorf("%s:%d: invalid checksum line", checksumFile, lineNumber)
+		}
+		if _, ok := entries[name]; !ok {
+			names = append(names, name)
+		}
+		entries[name] = append(entries[name], &checkEntry{algorithm: algorithm, expected: expected})
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	total, failed := 0, 0
+	for _, name := range names {
+		fileAlgorithms := make([]*hasher.Algorithm, len(entries[name]))
+		for i, entry := range entries[name] {
+			fileAlgorithms[i] = entry.algorithm
+		}
+		digests, err := hasher.SumFile(name, fileAlgorithms...)
+		for i, entry := range entries[name] {
+			total++
+			switch {
+			case err != nil:
+				fmt.Printf("%s: FAILED open or read\n", name)
+				failed++
+			case !strings.EqualFold(digests[i].HexStr(), entry.expected):
+				fmt.Printf("%s: FAILED\n", name)
+				failed++
+			default:
+				fmt.Printf("%s: OK\n", name)
+			}
+		}
+	}
+	return total, failed, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	// Environment variable to provide the password non-interactively, e.g. in scripts.
+	passwordEnv = "CRYPTOTOOL_PASSWORD"
+)
+
+var stdin = bufio.NewReader(os.Stdin)
+
+// Returns a secret read from terminal without echo, or a line of stdin if it is not a terminal.
+// Callers should wipe the secret with secmem.Wipe after use.
+func readSecret(prompt string) ([]byte, error) {
+	fd := int(os.Stdin.Fd())
+	if terminal.IsTerminal(fd) {
+		fmt.Fprint(os.Stderr, prompt)
+		secret, err := terminal.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		return secret, err
+	}
+	line, err := stdin.ReadBytes('\n')
+	if err != nil && len(line) == 0 {
+		return nil, err
+	}
+	secret := bytes.TrimRight(line, "\r\n")
+	return append([]byte{}, secret...), nil
+}
+
+// Returns the password from environment variable or prompt. If confirm is true,
+// the password is asked twice.
+func readPassword(confirm bool) ([]byte, error) {
+	if password, ok := os.LookupEnv(passwordEnv); ok {
+		return []byte(password), nil
+	}
+	password, err := readSecret("Password: ")
+	if err != nil {
+		return nil, err
+	}
+	if len(password) == 0 {
+		return nil, errors.New("password is required")
+	}
+	if confirm && terminal.IsTerminal(int(os.Stdin.Fd())) {
+		repeated, err := readSecret("Repeat password: ")
+		defer secmem.Wipe(repeated)
+		if err != nil {
+			secmem.Wipe(password)
+			return nil, err
+		}
+		if !bytes.Equal(password, repeated) {
+			secmem.Wipe(password)
+			return nil, errors.New("passwords do not match")
+		}
+	}
+	return password, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+// Command cryptotool is the command line interface of CryptoTool.
+//
+// Usage:
+//
+//	cryptotool <command> [subcommand] [flags] [arguments]
+//
+// Run a command with -h to show its flags.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// A command handles the arguments following its name.
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var commands = map[string]*command{
+	"hash":   {"hash files, stdin or strings and check digests", runHash},
+	"vanity": {"search vanity addresses", runVanity},
+	"vault":  {"manage accounts in an encrypted vault", runVault},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %s\n", os.Args[1])
+		printUsage()
+		os.Exit(2)
+	}
+	if err := cmd.run(os.Args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "Usage: cryptotool <command> [arguments]")
+	fmt.Fprintln(os.Stderr, "Commands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", name, commands[name].usage)
+	}
+}
+
+// Runs the subcommand of a command group named by the first argument.
+func runSubcommand(group string, subcommands map[string]*command, args []string) error {
+	if len(args) == 0 || subcommands[args[0]] == nil {
+		fmt.Fprintf(os.Stderr, "Usage: cryptotool %s <subcommand> [arguments]\n", group)
+		fmt.Fprintln(os.Stderr, "Subcommands:")
+		names := make([]string, 0, len(subcommands))
+		for name := range subcommands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(os.Stderr, "  %-10s %s\n", name, subcommands[name].usage)
+		}
+		if len(args) == 0 {
+			return flag.ErrHelp
+		}
+		return fmt.Errorf("unknown subcommand %s %s", group, args[0])
+	}
+	return subcommands[args[0]].run(args[1:])
+}
+
+// Returns a FlagSet which returns errors instead of exiting.
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet("cryptotool "+name, flag.ContinueOnError)
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"time"
+
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/lukaz17/cryptotool-go/vanity"
+)
+
+var vanityCommands = map[string]*command{
+	"solana": {"search a Solana address and write its Solana CLI keypair file", runVanitySolana},
+	"tron":   {"search a Tron address and print it with its private key as a JSON line", runVanityTron},
+}
+
+func runVanity(args []string) error {
+	return runSubcommand("vanity", vanityCommands, args)
+}
+
+func runVanitySolana(args []string) error {
+	flags := newFlagSet("vanity solana")
+	prefix := flags.String("prefix", "", "address prefix")
+	suffix := flags.String("suffix", "", "address suffix")
+	ignoreCase := flags.Bool("i", false, "match prefix and suffix case-insensitively")
+	workers := flags.Int("workers", runtime.NumCPU(), "number of concurrent workers")
+	output := flags.String("o", "", "keypair file to write, <address>.json if empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	matcher, err := vanity.NewSolanaMatcher(*prefix, *suffix, *ignoreCase)
+	if err != nil {
+		return err
+	}
+	result, err := searchVanity(vanity.NewSolanaGenerator(), matcher, *workers)
+	if err != nil {
+		return err
+	}
+	defer secmem.Wipe(result.Key)
+	keypair, err := vanity.SolanaKeypairJSON(result)
+	if err != nil {
+		return err
+	}
+	defer secmem.Wipe(keypair)
+	path := *output
+	if path == "" {
+		path = result.Address + ".json"
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(keypair); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	fmt.Printf("%s\n", result.Address)
+	fmt.Fprintf(os.Stderr, "wrote keypair file %s after %d attempts\n", path, result.Attempts)
+	return nil
+}
+
+func runVanityTron(args []string) error {
+	flags := newFlagSet("vanity tron")
+	prefix := flags.String("prefix", "", "address prefix starting with T")
+	suffix := flags.String("suffix", "", "address suffix")
+	workers := flags.Int("workers", runtime.NumCPU(), "number of concurrent workers")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	matcher, err := vanity.NewTronMatcher(*prefix, *suffix)
+	if err != nil {
+		return err
+	}
+	result, err := searchVanity(vanity.NewTronGenerator(), matcher, *workers)
+	if err != nil {
+		return err
+	}
+	defer secmem.Wipe(result.Key)
+	return vanity.NewResultWriter(os.Stdout).Write(result)
+}
+
+// Returns the first result of a search using workers, reporting progress to stderr until
+// found or interrupted.
+func searchVanity(generator vanity.GeneratorFactory, matcher vanity.Matcher, workers int) (*vanity.Result, error) {
+	fmt.Fprintf(os.Stderr, "difficulty: %.0f attempts on average, %d workers\n", matcher.Difficulty(), workers)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	results, err := vanity.Search(ctx, generator, matcher, &vanity.Options{
+		Workers:        workers,
+		ReportInterval: 5 * time.Second,
+		OnProgress:     printVanityProgress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+func printVanityProgress(progress *vanity.Progress) {
+	expected := "unknown"
+	if eta := progress.ExpectedTime(); eta >= 0 {
+		expected = eta.Round(time.Second).String()
+	}
+	fmt.Fprintf(os.Stderr, "%d attempts, %.0f/s, %.1f%% probability, expected time %s\n",
+		progress.Attempts, progress.Rate(), progress.Probability()*100, expected)
+}
@@ -0,0 +1,377 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/lukaz17/cryptotool-go/rotation"
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/lukaz17/cryptotool-go/vault"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	// Environment variable to override the default vault file.
+	vaultEnv = "CRYPTOTOOL_VAULT"
+)
+
+var vaultCommands = map[string]*command{
+	"init":   {"create a new empty vault", runVaultInit},
+	"add":    {"add an account from a mnemonic or private key", runVaultAdd},
+	"list":   {"list accounts without secrets", runVaultList},
+	"search": {"search accounts by text, tag:name or type:name", runVaultSearch},
+	"remove": {"remove accounts", runVaultRemove},
+	"export": {"export accounts including secrets in plaintext JSON", runVaultExport},
+	"rotate": {"mark an account as compromised, create its replacement and print the migration plan", runVaultRotate},
+	"canary": {"derive a canary account guarding the mnemonic of an account", runVaultCanary},
+	"watch":  {"export canary, guarded and compromised addresses as a watch list", runVaultWatch},
+}
+
+func runVault(args []string) error {
+	return runSubcommand("vault", vaultCommands, args)
+}
+
+// Returns the default vault file from environment variable or home directory.
+func defaultVaultPath() string {
+	if path := os.Getenv(vaultEnv); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "vault.json"
+	}
+	return filepath.Join(home, ".cryptotool", "vault.json")
+}
+
+func vaultFlagSet(name string) (*flag.FlagSet, *string) {
+	flags := newFlagSet("vault " + name)
+	path := flags.String("file", defaultVaultPath(), "vault file, can be set by "+vaultEnv)
+	return flags, path
+}
+
+// Returns the vault at path decrypted with a password from environment variable or prompt.
+func openVault(path string) (*vault.Vault, error) {
+	password, err := readPassword(false)
+	if err != nil {
+		return nil, err
+	}
+	defer secmem.Wipe(password)
+	return vault.Open(path, password)
+}
+
+func runVaultInit(args []string) error {
+	flags, path := vaultFlagSet("init")
+	kdf := flags.String("kdf", "argon2id", "key derivation function: argon2id or scrypt")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(*path), 0700); err != nil {
+		return err
+	}
+	password, err := readPassword(true)
+	if err != nil {
+		return err
+	}
+	defer secmem.Wipe(password)
+	v, err := vault.Create(*path, password, &vault.Options{KDF: *kdf})
+	if err != nil {
+		return err
+	}
+	defer v.Close()
+	fmt.Fprintf(os.Stderr, "created vault %s\n", v.Path())
+	return nil
+}
+
+func runVaultAdd(args []string) error {
+	flags, path := vaultFlagSet("add")
+	label := flags.String("label", "", "unique label of the account")
+	accountType := flags.String("type", string(vault.EthereumAccount), "account type: ethereum or bitcoin")
+	network := flags.String("network", "", "bitcoin network: mainnet or testnet")
+	addressType := flags.String("address-type", "", "bitcoin address type: p2pkh, p2wpkh or p2tr")
+	derivationPath := flags.String("path", "", "derivation path, default path of account type if empty")
+	tags := flags.String("tags", "", "comma-separated tags")
+	withPassphrase := flags.Bool("passphrase", false, "prompt for the BIP-39 passphrase of the mnemonic")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	v, err := openVault(*path)
+	if err != nil {
+		return err
+	}
+	defer v.Close()
+	entry := &vault.Entry{
+		Label:          *label,
+		Type:           vault.AccountType(*accountType),
+		Network:        *network,
+		AddressType:    *addressType,
+		DerivationPath: *derivationPath,
+	}
+	if *tags != "" {
+		entry.Tags = strings.Split(*tags, ",")
+	}
+	secret, err := readSecret("Mnemonic or private key: ")
+	if err != nil {
+		return err
+	}
+	defer secmem.Wipe(secret)
+	hexKey := bytes.TrimPrefix(bytes.TrimSpace(secret), []byte("0x"))
+	privateKey := make([]byte, hex.DecodedLen(len(hexKey)))
+	defer secmem.Wipe(privateKey)
+	if n, err := hex.Decode(privateKey, hexKey); err == nil && n == 32 {
+		entry.PrivateKey = privateKey
+	} else {
+		entry.Mnemonic = stdx.Bytes(secret)
+	}
+	if *withPassphrase {
+		passphrase, err := readSecret("Passphrase: ")
+		if err != nil {
+			return err
+		}
+		defer secmem.Wipe(passphrase)
+		entry.Passphrase = stdx.Bytes(passphrase)
+	}
+	if err := v.Add(entry); err != nil {
+		return err
+	}
+	if err := v.Save(); err != nil {
+		return err
+	}
+	fmt.Printf("%s\t%s\n", entry.ID, entry.Address)
+	return nil
+}
+
+func runVaultList(args []string) error {
+	flags, path := vaultFlagSet("list")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	v, err := openVault(*path)
+	if err != nil {
+		return err
+	}
+	defer v.Close()
+	return printEntries(os.Stdout, v.Entries())
+}
+
+func runVaultSearch(args []string) error {
+	flags, path := vaultFlagSet("search")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	v, err := openVault(*path)
+	if err != nil {
+		return err
+	}
+	defer v.Close()
+	return printEntries(os.Stdout, v.Search(strings.Join(flags.Args(), " ")))
+}
+
+func runVaultRemove(args []string) error {
+	flags, path := vaultFlagSet("remove")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return fmt.Errorf("at least one id, label or address is required")
+	}
+	v, err := openVault(*path)
+	if err != nil {
+		return err
+	}
+	defer v.Close()
+	for _, ref := range flags.Args() {
+		entry, err := v.Remove(ref)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "removed %s\n", entry)
+	}
+	return v.Save()
+}
+
+func runVaultExport(args []string) error {
+	flags, path := vaultFlagSet("export")
+	out := flags.String("out", "", "output file, stdout if empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	v, err := openVault(*path)
+	if err != nil {
+		return err
+	}
+	defer v.Close()
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		file, err := os.OpenFile(*out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	fmt.Fprintln(os.Stderr, "warning: exported data contains secrets in plaintext")
+	return v.Export(w, flags.Args()...)
+}
+
+func runVaultRotate(args []string) error {
+	flags, path := vaultFlagSet("rotate")
+	label := flags.String("label", "", "label of the replacement, old label with suffix -rotated if empty")
+	chainID := flags.String("chain-id", "1", "chain id of ethereum transactions")
+	nonce := flags.Uint64("nonce", 0, "next nonce of the compromised account")
+	tip := flags.String("tip", "", "max priority fee per gas in wei")
+	maxFee := flags.String("max-fee", "", "max fee per gas in wei")
+	balance := flags.String("balance", "", "ETH balance of the compromised account in wei, sweep is a manual step if empty")
+	tokensPath := flags.String("tokens", "", "JSON file of tokens: [{\"symbol\", \"address\", \"amount\", \"spenders\"}]")
+	out := flags.String("out", "", "write the plan in JSON to this file")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return fmt.Errorf("exactly one id, label or address is required")
+	}
+	options := &rotation.Options{Nonce: *nonce}
+	var err error
+	for _, value := range []struct {
+		name  string
+		str   string
+		value **big.Int
+	}{
+		{"chain-id", *chainID, &options.ChainID},
+		{"tip", *tip, &options.GasTipCap},
+		{"max-fee", *maxFee, &options.GasFeeCap},
+		{"balance", *balance, &options.Balance},
+	} {
+		if value.str == "" {
+			continue
+		}
+		var ok bool
+		if *value.value, ok = new(big.Int).SetString(value.str, 10); !ok || (*value.value).Sign() < 0 {
+			return fmt.Errorf("invalid %s %s", value.name, value.str)
+		}
+	}
+	if *tokensPath != "" {
+		data, err := os.ReadFile(*tokensPath)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &options.Tokens); err != nil {
+			return fmt.Errorf("invalid tokens file: %w", err)
+		}
+	}
+
+	v, err := openVault(*path)
+	if err != nil {
+		return err
+	}
+	defer v.Close()
+	compromised, err := v.Get(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	replacement, err := v.Rotate(compromised.ID, *label)
+	if err != nil {
+		return err
+	}
+	shared, err := v.SharingSecret(compromised.ID)
+	if err != nil {
+		return err
+	}
+	plan, err := rotation.NewPlan(compromised, replacement, shared, options)
+	if err != nil {
+		return err
+	}
+	if err := v.Save(); err != nil {
+		return err
+	}
+	fmt.Print(plan)
+	if *out != "" {
+		data, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(*out, data, 0600)
+	}
+	return nil
+}
+
+func runVaultCanary(args []string) error {
+	flags, path := vaultFlagSet("canary")
+	label := flags.String("label", "", "label of the canary, account label with suffix -canary-index if empty")
+	index := flags.Uint("index", 0, "address index of the canary")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return fmt.Errorf("exactly one id, label or address is required")
+	}
+	v, err := openVault(*path)
+	if err != nil {
+		return err
+	}
+	defer v.Close()
+	canary, err := v.AddCanary(flags.Arg(0), uint32(*index), *label)
+	if err != nil {
+		return err
+	}
+	if err := v.Save(); err != nil {
+		return err
+	}
+	fmt.Printf("%s\t%s\t%s\n", canary.ID, canary.Address, canary.DerivationPath)
+	return nil
+}
+
+func runVaultWatch(args []string) error {
+	flags, path := vaultFlagSet("watch")
+	format := flags.String("format", "json", "output format: json or csv")
+	out := flags.String("out", "", "output file, stdout if empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	write := vault.WriteWatchListJSON
+	switch *format {
+	case "json":
+	case "csv":
+		write = vault.WriteWatchListCSV
+	default:
+		return fmt.Errorf("unsupported format %s", *format)
+	}
+	v, err := openVault(*path)
+	if err != nil {
+		return err
+	}
+	defer v.Close()
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	return write(w, v.WatchList())
+}
+
+func printEntries(w io.Writer, entries []*vault.Entry) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "ID\tLABEL\tTYPE\tADDRESS\tPATH\tTAGS")
+	for _, entry := range entries {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\n", entry.ID, entry.Label, entry.Type, entry.Address, entry.DerivationPath, strings.Join(entry.Tags, ","))
+	}
+	return table.Flush()
+}
@@ -0,0 +1,225 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package encryptor
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/lukaz17/cryptotool-go/hasher"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	ScryptKDF   = "scrypt"
+	Argon2idKDF = "argon2id"
+
+	saltLength = 32
+	keyLength  = 32
+)
+
+// ErrDecryption is returned when the password is wrong or the data was tampered.
+var ErrDecryption = errors.New("cannot decrypt data: wrong password or corrupted data")
+
+// ScryptParams contains cost parameters of scrypt key derivation, see hasher.CalibrateScrypt.
+type ScryptParams = hasher.ScryptParams
+
+// Returns recommended scrypt parameters for interactive use.
+func DefaultScryptParams() *ScryptParams {
+	return &ScryptParams{
+		N: 1 << 15,
+		R: 8,
+		P: 1,
+	}
+}
+
+// Argon2Params contains cost parameters of Argon2id key derivation, see hasher.CalibrateArgon2id.
+type Argon2Params = hasher.Argon2Params
+
+// Returns recommended Argon2id parameters following the second recommended option of RFC 9106.
+func DefaultArgon2Params() *Argon2Params {
+	return &Argon2Params{
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}
+}
+
+// An EncryptedData contains a ciphertext with everything needed to decrypt it except the password.
+// It can be marshalled to JSON with binary fields in hex.
+type EncryptedData struct {
+	KDF        string
+	Scrypt     *ScryptParams
+	Argon2     *Argon2Params
+	Salt       stdx.Bytes
+	Nonce      stdx.Bytes
+	Ciphertext stdx.Bytes
+}
+
+// Returns plaintext encrypted with a key derived from password using default parameters.
+func Encrypt(plaintext, password []byte) (*EncryptedData, error) {
+	return EncryptWithParams(plaintext, password, DefaultScryptParams())
+}
+
+// Returns plaintext encrypted with a key derived from password using provided scrypt parameters.
+func EncryptWithParams(plaintext, password []byte, params *ScryptParams) (*EncryptedData, error) {
+	return seal(plaintext, password, &EncryptedData{
+		KDF:    ScryptKDF,
+		Scrypt: &ScryptParams{N: params.N, R: params.R, P: params.P},
+	})
+}
+
+// Returns plaintext encrypted with a key derived from password using Argon2id with provided parameters.
+func EncryptWithArgon2(plaintext, password []byte, params *Argon2Params) (*EncryptedData, error) {
+	if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+		return nil, errors.New("argon2 parameters must be positive")
+	}
+	return seal(plaintext, password, &EncryptedData{
+		KDF:    Argon2idKDF,
+		Argon2: &Argon2Params{Time: params.Time, Memory: params.Memory, Threads: params.Threads},
+	})
+}
+
+// Returns plaintext encrypted using the key derivation function and parameters of template.
+// This is useful to re-encrypt modified data with the parameters it was encrypted with.
+func EncryptLike(plaintext, password []byte, template *EncryptedData) (*EncryptedData, error) {
+	switch {
+	case template != nil && template.KDF == ScryptKDF && template.Scrypt != nil:
+		return EncryptWithParams(plaintext, password, template.Scrypt)
+	case template != nil && template.KDF == Argon2idKDF && template.Argon2 != nil:
+		return EncryptWithArgon2(plaintext, password, template.Argon2)
+	}
+	return nil, errors.New("unsupported key derivation function")
+}
+
+// Returns the plaintext of data. ErrDecryption is returned if the password is wrong
+// or any field was modified.
+func Decrypt(data *EncryptedData, password []byte) ([]byte, error) {
+	if data == nil {
+		return nil, errors.New("unsupported key derivation function")
+	}
+	key, err := data.deriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data.Nonce) != aead.NonceSize() {
+		return nil, ErrDecryption
+	}
+	plaintext, err := aead.Open(nil, data.Nonce, data.Ciphertext, data.additionalData())
+	if err != nil {
+		return nil, ErrDecryption
+	}
+	return plaintext, nil
+}
+
+// Fills salt, nonce and ciphertext of data whose KDF and parameters are already set.
+func seal(plaintext, password []byte, data *EncryptedData) (*EncryptedData, error) {
+	data.Salt = make([]byte, saltLength)
+	if _, err := rand.Read(data.Salt); err != nil {
+		return nil, err
+	}
+	key, err := data.deriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	data.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(data.Nonce); err != nil {
+		return nil, err
+	}
+	data.Ciphertext = aead.Seal(nil, data.Nonce, plaintext, data.additionalData())
+	return data, nil
+}
+
+// Returns the encryption key derived from password using the KDF of data.
+func (d *EncryptedData) deriveKey(password []byte) ([]byte, error) {
+	switch {
+	case d.KDF == ScryptKDF && d.Scrypt != nil:
+		return hasher.Scrypt(password, d.Salt, d.Scrypt, keyLength)
+	case d.KDF == Argon2idKDF && d.Argon2 != nil:
+		return hasher.Argon2id(password, d.Salt, d.Argon2, keyLength)
+	}
+	return nil, errors.New("unsupported key derivation function")
+}
+
+// Binds KDF parameters to the ciphertext so they cannot be altered without detection.
+func (d *EncryptedData) additionalData() []byte {
+	if d.KDF == Argon2idKDF {
+		return []byte(fmt.Sprintf("%s:%d:%d:%d", d.KDF, d.Argon2.Time, d.Argon2.Memory, d.Argon2.Threads))
+	}
+	return []byte(fmt.Sprintf("%s:%d:%d:%d", d.KDF, d.Scrypt.N, d.Scrypt.R, d.Scrypt.P))
+}
+
+type jsonEncryptedData struct {
+	KDF        string        `json:"kdf"`
+	Scrypt     *ScryptParams `json:"scrypt,omitempty"`
+	Argon2     *Argon2Params `json:"argon2,omitempty"`
+	Salt       string        `json:"salt"`
+	Nonce      string        `json:"nonce"`
+	Ciphertext string        `json:"ciphertext"`
+}
+
+// Returns JSON encoding of data with binary fields in hex.
+func (d *EncryptedData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&jsonEncryptedData{
+		KDF:        d.KDF,
+		Scrypt:     d.Scrypt,
+		Argon2:     d.Argon2,
+		Salt:       d.Salt.HexStr(),
+		Nonce:      d.Nonce.HexStr(),
+		Ciphertext: d.Ciphertext.HexStr(),
+	})
+}
+
+// Parses JSON encoding produced by MarshalJSON.
+func (d *EncryptedData) UnmarshalJSON(data []byte) error {
+	var j jsonEncryptedData
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	salt, err := hex.DecodeString(j.Salt)
+	if err != nil {
+		return err
+	}
+	nonce, err := hex.DecodeString(j.Nonce)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := hex.DecodeString(j.Ciphertext)
+	if err != nil {
+		return err
+	}
+	*d = EncryptedData{
+		KDF:        j.KDF,
+		Scrypt:     j.Scrypt,
+		Argon2:     j.Argon2,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	}
+	return nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package encryptor
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	params := &ScryptParams{N: 1024, R: 8, P: 1}
+	plaintext := []byte("6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355")
+	password := []byte("correct horse battery staple")
+	tests := []struct {
+		name     string
+		password []byte
+		tamper   func(*EncryptedData)
+		isValid  bool
+	}{
+		{"valid", password, func(d *EncryptedData) {}, true},
+		{"wrong_password", []byte("wrong password"), func(d *EncryptedData) {}, false},
+		{"tampered_ciphertext", password, func(d *EncryptedData) { d.Ciphertext[0] ^= 1 }, false},
+		{"tampered_params", password, func(d *EncryptedData) { d.Scrypt.N = 2048 }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encrypted, err := EncryptWithParams(plaintext, password, params)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if bytes.Contains(encrypted.Ciphertext, plaintext) {
+				t.Fatalf("ciphertext contains plaintext")
+			}
+			serialized, _ := json.Marshal(encrypted)
+			var restored EncryptedData
+			if err := json.Unmarshal(serialized, &restored); err != nil {
+				t.Fatalf("cannot unmarshal encrypted data: %v", err)
+			}
+			tt.tamper(&restored)
+			decrypted, err := Decrypt(&restored, tt.password)
+			if !tt.isValid {
+				if err == nil {
+					t.Errorf("expected decryption error")
+				}
+				return
+			}
+			if err != nil || !bytes.Equal(decrypted, plaintext) {
+				t.Errorf("invalid plaintext. expected %s actual %s", plaintext, decrypted)
+			}
+		})
+	}
+}
+
+func TestEncryptWithArgon2(t *testing.T) {
+	params := &Argon2Params{Time: 1, Memory: 1024, Threads: 1}
+	plaintext := []byte("repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat rescue")
+	password := []byte("correct horse battery staple")
+	tests := []struct {
+		name     string
+		password []byte
+		tamper   func(*EncryptedData)
+		isValid  bool
+	}{
+		{"valid", password, func(d *EncryptedData) {}, true},
+		{"wrong_password", []byte("wrong password"), func(d *EncryptedData) {}, false},
+		{"tampered_salt", password, func(d *EncryptedData) { d.Salt[0] ^= 1 }, false},
+		{"tampered_params", password, func(d *EncryptedData) { d.Argon2.Time = 2 }, false},
+		{"tampered_kdf", password, func(d *EncryptedData) { d.KDF = ScryptKDF }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encrypted, err := EncryptWithArgon2(plaintext, password, params)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			serialized, _ := json.Marshal(encrypted)
+			var restored EncryptedData
+			if err := json.Unmarshal(serialized, &restored); err != nil {
+				t.Fatalf("cannot unmarshal encrypted data: %v", err)
+			}
+			tt.tamper(&restored)
+			decrypted, err := Decrypt(&restored, tt.password)
+			if !tt.isValid {
+				if err == nil {
+					t.Errorf("expected decryption error")
+				}
+				return
+			}
+			if err != nil || !bytes.Equal(decrypted, plaintext) {
+				t.Errorf("invalid plaintext. expected %s actual %s", plaintext, decrypted)
+			}
+			reencrypted, err := EncryptLike(plaintext, password, &restored)
+			if err != nil || reencrypted.KDF != Argon2idKDF || *reencrypted.Argon2 != *params || bytes.Equal(reencrypted.Salt, restored.Salt) {
+				t.Errorf("invalid re-encryption %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package encryptor provides APIs to encrypt secrets with a password before they are written to disk.
+The encryption key is derived from the password using scrypt or Argon2id and data is sealed using AES-256-GCM.
+*/
+package encryptor
@@ -0,0 +1,61 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package hasher
+
+import (
+	"crypto/sha512"
+	"fmt"
+
+	"github.com/tforce-io/tf-golib/stdx"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+)
+
+func Sha512(data stdx.Bytes) stdx.Bytes {
+	hash := sha512.Sum512(data)
+	return stdx.Bytes(hash[:])
+}
+
+// Returns standard SHA3-256 defined in FIPS 202, which differs from Keccak256 used by Ethereum.
+func Sha3_256(data stdx.Bytes) stdx.Bytes {
+	hash := sha3.Sum256(data)
+	return stdx.Bytes(hash[:])
+}
+
+// Returns standard SHA3-512 defined in FIPS 202, which differs from Keccak512.
+func Sha3_512(data stdx.Bytes) stdx.Bytes {
+	hash := sha3.Sum512(data)
+	return stdx.Bytes(hash[:])
+}
+
+// Returns legacy Keccak-512 before FIPS 202 padding.
+func Keccak512(data stdx.Bytes) stdx.Bytes {
+	hasher := sha3.NewLegacyKeccak512()
+	hasher.Write(data)
+	hash := hasher.Sum(nil)
+	return stdx.Bytes(hash)
+}
+
+// Returns unkeyed BLAKE2b digest of size bytes, size must be between 1 and 64.
+func Blake2b(data stdx.Bytes, size int) (stdx.Bytes, error) {
+	hasher, err := blake2b.New(size, nil)
+	if err != nil {
+		return nil, fmt.Errorf("blake2b size must be between 1 and %d bytes", blake2b.Size)
+	}
+	hasher.Write(data)
+	return stdx.Bytes(hasher.Sum(nil)), nil
+}
+
+// Returns unkeyed BLAKE2s digest of size bytes, size must be between 1 and 32.
+func Blake2s(data stdx.Bytes, size int) (stdx.Bytes, error) {
+	if size < 1 || size > blake2sMaxSize {
+		return nil, fmt.Errorf("blake2s size must be between 1 and %d bytes", blake2sMaxSize)
+	}
+	hasher := newBlake2s(size)
+	hasher.Write(data)
+	return stdx.Bytes(hasher.Sum(nil)), nil
+}
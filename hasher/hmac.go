@@ -0,0 +1,43 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package hasher
+
+import (
+	"crypto/hmac"
+	"io"
+
+	"github.com/tforce-io/tf-golib/stdx"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Returns HMAC of data keyed by key using algorithm following RFC 2104.
+func HMAC(algorithm *Algorithm, key, data stdx.Bytes) stdx.Bytes {
+	mac := hmac.New(algorithm.new, key)
+	mac.Write(data)
+	return stdx.Bytes(mac.Sum(nil))
+}
+
+// Returns the pseudorandom key extracted from secret and salt following HKDF defined in RFC 5869.
+// An empty salt is replaced by zeros of the digest size.
+func HKDFExtract(algorithm *Algorithm, secret, salt stdx.Bytes) stdx.Bytes {
+	return stdx.Bytes(hkdf.Extract(algorithm.new, secret, salt))
+}
+
+// Returns length bytes of key material expanded from pseudorandomKey and info following HKDF
+// defined in RFC 5869. length must not exceed 255 times the digest size.
+func HKDFExpand(algorithm *Algorithm, pseudorandomKey, info stdx.Bytes, length int) (stdx.Bytes, error) {
+	key := make(stdx.Bytes, length)
+	if _, err := io.ReadFull(hkdf.Expand(algorithm.new, pseudorandomKey, info), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Returns length bytes of key material derived from secret, salt and info using HKDF extract and expand.
+func HKDF(algorithm *Algorithm, secret, salt, info stdx.Bytes, length int) (stdx.Bytes, error) {
+	return HKDFExpand(algorithm, HKDFExtract(algorithm, secret, salt), info, length)
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package hasher
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tforce-io/tf-golib/stdx"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	minPBKDF2Iterations = 1000
+	minScryptN          = 1 << 10
+	maxScryptN          = 1 << 20
+	calibrationSalt     = "cryptotool-calibration"
+)
+
+// PBKDF2Params contains parameters of PBKDF2 key derivation. Hash is the name of
+// a registered algorithm used by HMAC.
+type PBKDF2Params struct {
+	Iterations int    `json:"iterations"`
+	Hash       string `json:"hash"`
+}
+
+// ScryptParams contains cost parameters of scrypt key derivation.
+type ScryptParams struct {
+	N int `json:"n"`
+	R int `json:"r"`
+	P int `json:"p"`
+}
+
+// Argon2Params contains cost parameters of Argon2id key derivation.
+// Memory is in KiB.
+type Argon2Params struct {
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"`
+	Threads uint8  `json:"threads"`
+}
+
+// Returns a key of keyLength bytes derived from password and salt using PBKDF2 defined in RFC 8018.
+func PBKDF2(password, salt stdx.Bytes, params *PBKDF2Params, keyLength int) (stdx.Bytes, error) {
+	if params.Iterations <= 0 {
+		return nil, errors.New("pbkdf2 iterations must be positive")
+	}
+	algorithm, err := Get(params.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return stdx.Bytes(pbkdf2.Key(password, salt, params.Iterations, keyLength, algorithm.new)), nil
+}
+
+// Returns a key of keyLength bytes derived from password and salt using scrypt defined in RFC 7914.
+func Scrypt(password, salt stdx.Bytes, params *ScryptParams, keyLength int) (stdx.Bytes, error) {
+	key, err := scrypt.Key(password, salt, params.N, params.R, params.P, keyLength)
+	if err != nil {
+		return nil, err
+	}
+	return stdx.Bytes(key), nil
+}
+
+// Returns a key of keyLength bytes derived from password and salt using Argon2id defined in RFC 9106.
+func Argon2id(password, salt stdx.Bytes, params *Argon2Params, keyLength int) (stdx.Bytes, error) {
+	if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+		return nil, errors.New("argon2 parameters must be positive")
+	}
+	return stdx.Bytes(argon2.IDKey(password, salt, params.Time, params.Memory, params.Threads, uint32(keyLength))), nil
+}
+
+// Returns PBKDF2 parameters with hash whose derivation takes about target on the current machine,
+// at least 1000 iterations. The iteration count is extrapolated from a measured run.
+func CalibratePBKDF2(hash string, target time.Duration) (*PBKDF2Params, error) {
+	params := &PBKDF2Params{Iterations: minPBKDF2Iterations, Hash: hash}
+	for {
+		elapsed, err := measure(func() error {
+			_, err := PBKDF2([]byte(calibrationSalt), []byte(calibrationSalt), params, 32)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Short runs are dominated by noise, keep doubling until a quarter of the target is reached.
+		if elapsed >= target/4 {
+			params.Iterations = int(float64(params.Iterations) * float64(target) / float64(elapsed))
+			break
+		}
+		params.Iterations *= 2
+	}
+	if params.Iterations < minPBKDF2Iterations {
+		params.Iterations = minPBKDF2Iterations
+	}
+	return params, nil
+}
+
+// Returns scrypt parameters with r = 8 and p = 1 whose derivation takes at most target on
+// the current machine. N is the largest power of 2 within the target, between 2^10 and 2^20.
+func CalibrateScrypt(target time.Duration) (*ScryptParams, error) {
+	params := &ScryptParams{N: minScryptN, R: 8, P: 1}
+	for params.N < maxScryptN {
+		elapsed, err := measure(func() error {
+			_, err := Scrypt([]byte(calibrationSalt), []byte(calibrationSalt), params, 32)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Doubling N doubles the duration.
+		if elapsed*2 > target {
+			break
+		}
+		params.N *= 2
+	}
+	return params, nil
+}
+
+// Returns Argon2id parameters with memory in KiB and threads whose derivation takes about target
+// on the current machine. Following RFC 9106, memory is fixed and the number of passes is tuned.
+func CalibrateArgon2id(target time.Duration, memory uint32, threads uint8) (*Argon2Params, error) {
+	params := &Argon2Params{Time: 1, Memory: memory, Threads: threads}
+	elapsed, err := measure(func() error {
+		_, err := Argon2id([]byte(calibrationSalt), []byte(calibrationSalt), params, 32)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if passes := uint32((target + elapsed/2) / elapsed); passes > 1 {
+		params.Time = passes
+	}
+	return params, nil
+}
+
+// Returns the duration of f.
+func measure(f func() error) (time.Duration, error) {
+	start := time.Now()
+	err := f()
+	elapsed := time.Since(start)
+	if elapsed <= 0 {
+		elapsed = time.Nanosecond
+	}
+	return elapsed, err
+}
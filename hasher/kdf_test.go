@@ -0,0 +1,131 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package hasher
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+func TestHMAC(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm string
+		key       []byte
+		data      []byte
+		mac       string
+	}{
+		{"sha256", "sha256", bytes.Repeat([]byte{0x0b}, 20), []byte("Hi There"), "b0344c61d8db38535ca8afceaf0bf12b881dc200c9833da726e9376c2e32cff7"},
+		{"sha512", "sha512", []byte("Jefe"), []byte("what do ya want for nothing?"), "164b7a7bfcf819e2e395fbe73b56e0a387bd64222e831fd610270cd7ea2505549758bf75c05a994a6d034f65f8f0e6fdcaeab1a34d4a6b4b636e070a38bce737"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			algorithm, _ := Get(tt.algorithm)
+			mac := HMAC(algorithm, tt.key, tt.data)
+			if mac.HexStr() != tt.mac {
+				t.Errorf("invalid mac. expected %s actual %s", tt.mac, mac.HexStr())
+			}
+		})
+	}
+}
+
+func TestHKDF(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		salt   string
+		info   string
+		prk    string
+		okm    string
+	}{
+		{"rfc5869_case_1", "0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b", "000102030405060708090a0b0c", "f0f1f2f3f4f5f6f7f8f9",
+			"077709362c2e32df0ddc3f0dc47bba6390b6c73bb50f9c3122ec844ad7c2b3e5",
+			"3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865"},
+		{"rfc5869_case_3", "0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b", "", "",
+			"19ef24a32c717b167f33a91d6f648bdf96596776afdb6377ac434c1c293ccb04",
+			"8da4e775a563c18f715f802a063c5a31b8a11f5c5ee1879ec3454e5f3c738d2d9d201395faa4b61a96c8"},
+	}
+	algorithm, _ := Get("sha256")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret, salt, info := decodeHex(tt.secret), decodeHex(tt.salt), decodeHex(tt.info)
+			prk := HKDFExtract(algorithm, secret, salt)
+			if prk.HexStr() != tt.prk {
+				t.Errorf("invalid prk. expected %s actual %s", tt.prk, prk.HexStr())
+			}
+			okm, err := HKDF(algorithm, secret, salt, info, len(tt.okm)/2)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if okm.HexStr() != tt.okm {
+				t.Errorf("invalid okm. expected %s actual %s", tt.okm, okm.HexStr())
+			}
+		})
+	}
+}
+
+func TestKDF(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		salt     string
+		derive   func(password, salt stdx.Bytes, keyLength int) (stdx.Bytes, error)
+		key      string
+	}{
+		{"pbkdf2_sha256_1", "password", "salt", func(password, salt stdx.Bytes, keyLength int) (stdx.Bytes, error) {
+			return PBKDF2(password, salt, &PBKDF2Params{Iterations: 1, Hash: "sha256"}, keyLength)
+		}, "120fb6cffcf8b32c43e7225256c4f837a86548c92ccc35480805987cb70be17b"},
+		{"pbkdf2_sha256_4096", "password", "salt", func(password, salt stdx.Bytes, keyLength int) (stdx.Bytes, error) {
+			return PBKDF2(password, salt, &PBKDF2Params{Iterations: 4096, Hash: "sha256"}, keyLength)
+		}, "c5e478d59288c841aa530db6845c4c8d962893a001ce4e11a4963873aa98134a"},
+		{"scrypt_rfc7914", "password", "NaCl", func(password, salt stdx.Bytes, keyLength int) (stdx.Bytes, error) {
+			return Scrypt(password, salt, &ScryptParams{N: 1024, R: 8, P: 16}, keyLength)
+		}, "fdbabe1c9d3472007856e7190d01e9fe7c6ad7cbc8237830e77376634b3731622eaf30d92e22a3886ff109279d9830dac727afb94a83ee6d8360cbdfa2cc0640"},
+		{"argon2id", "password", "somesalt", func(password, salt stdx.Bytes, keyLength int) (stdx.Bytes, error) {
+			return Argon2id(password, salt, &Argon2Params{Time: 1, Memory: 64, Threads: 1}, keyLength)
+		}, "655ad15eac652dc59f7170a7332bf49b8469be1fdb9c28bb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := tt.derive([]byte(tt.password), []byte(tt.salt), len(tt.key)/2)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if key.HexStr() != tt.key {
+				t.Errorf("invalid key. expected %s actual %s", tt.key, key.HexStr())
+			}
+		})
+	}
+}
+
+func TestCalibrate(t *testing.T) {
+	target := 20 * time.Millisecond
+	pbkdf2Params, err := CalibratePBKDF2("sha256", target)
+	if err != nil || pbkdf2Params.Iterations < minPBKDF2Iterations {
+		t.Errorf("invalid pbkdf2 params %+v, error %v", pbkdf2Params, err)
+	}
+	scryptParams, err := CalibrateScrypt(target)
+	if err != nil || scryptParams.N < minScryptN || scryptParams.N&(scryptParams.N-1) != 0 {
+		t.Errorf("invalid scrypt params %+v, error %v", scryptParams, err)
+	}
+	argon2Params, err := CalibrateArgon2id(target, 1024, 1)
+	if err != nil || argon2Params.Time < 1 || argon2Params.Memory != 1024 || argon2Params.Threads != 1 {
+		t.Errorf("invalid argon2 params %+v, error %v", argon2Params, err)
+	}
+	if _, err := CalibratePBKDF2("md5", target); err == nil {
+		t.Errorf("expected error with unsupported hash")
+	}
+}
+
+func decodeHex(value string) []byte {
+	data, _ := hex.DecodeString(value)
+	return data
+}
@@ -6,5 +6,13 @@
 
 /*
 Package hasher provides shorthand APIs to hash byte slice.
+
+Supported digests are SHA-256, double SHA-256, RIPEMD-160, Hash160, SHA-512, SHA3-256, SHA3-512,
+Keccak-256, Keccak-512, BLAKE2b, BLAKE2s and BIP-340 tagged hashes. Get selects an algorithm by name,
+e.g. hasher.Get("sha3-256"), so commands and key formats can pick algorithms dynamically.
+SumReader and SumFile stream data through several algorithms in one pass.
+
+HMAC and HKDF work with any registered algorithm. PBKDF2, Scrypt and Argon2id derive keys from passwords,
+and their Calibrate functions pick parameters hitting a target duration on the current machine.
 */
 package hasher
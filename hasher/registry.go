@@ -0,0 +1,151 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package hasher
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tforce-io/tf-golib/stdx"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+)
+
+const taggedPrefix = "tagged:"
+
+// An Algorithm is a hash function which can be selected by name with Get.
+type Algorithm struct {
+	Name string
+	Size int
+	new  func() hash.Hash
+}
+
+var algorithms = map[string]*Algorithm{}
+
+func init() {
+	register("sha256", sha256.Size, sha256.New)
+	register("double-sha256", sha256.Size, func() hash.Hash { return newChained(sha256.New(), sha256.New) })
+	register("ripemd160", ripemd160.Size, ripemd160.New)
+	register("hash160", ripemd160.Size, func() hash.Hash { return newChained(sha256.New(), ripemd160.New) })
+	register("sha512", sha512.Size, sha512.New)
+	register("sha3-256", 32, sha3.New256)
+	register("sha3-512", 64, sha3.New512)
+	register("keccak256", 32, sha3.NewLegacyKeccak256)
+	register("keccak512", 64, sha3.NewLegacyKeccak512)
+	register("blake2b-256", 32, func() hash.Hash { return newBlake2b(32) })
+	register("blake2b-512", 64, func() hash.Hash { return newBlake2b(64) })
+	register("blake2s-128", 16, func() hash.Hash { return newBlake2s(16) })
+	register("blake2s-256", 32, func() hash.Hash { return newBlake2s(32) })
+}
+
+func register(name string, size int, new func() hash.Hash) {
+	algorithms[name] = &Algorithm{Name: name, Size: size, new: new}
+}
+
+// Returns the algorithm of name, case-insensitive. Besides names returned by Names,
+// "blake2b-<bits>" and "blake2s-<bits>" select BLAKE2 digests of any size in multiple of 8 bits,
+// and "tagged:<tag>" selects the BIP-340 tagged hash of tag.
+func Get(name string) (*Algorithm, error) {
+	if strings.HasPrefix(strings.ToLower(name), taggedPrefix) {
+		tag := name[len(taggedPrefix):]
+		return &Algorithm{
+			Name: taggedPrefix + tag,
+			Size: sha256.Size,
+			new:  func() hash.Hash { return newTagged(tag) },
+		}, nil
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	if algorithm, ok := algorithms[name]; ok {
+		return algorithm, nil
+	}
+	for prefix, maxSize := range map[string]int{"blake2b-": blake2b.Size, "blake2s-": blake2sMaxSize} {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		bits, err := strconv.Atoi(name[len(prefix):])
+		if err != nil || bits <= 0 || bits%8 != 0 || bits > maxSize*8 {
+			return nil, fmt.Errorf("%s size must be a multiple of 8 bits up to %d bits", strings.TrimSuffix(prefix, "-"), maxSize*8)
+		}
+		size := bits / 8
+		if prefix == "blake2b-" {
+			return &Algorithm{Name: name, Size: size, new: func() hash.Hash { return newBlake2b(size) }}, nil
+		}
+		return &Algorithm{Name: name, Size: size, new: func() hash.Hash { return newBlake2s(size) }}, nil
+	}
+	return nil, fmt.Errorf("unsupported hash algorithm %s", name)
+}
+
+// Returns names of registered algorithms in alphabetical order.
+func Names() []string {
+	names := make([]string, 0, len(algorithms))
+	for name := range algorithms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Returns a new hash.Hash of the algorithm.
+func (a *Algorithm) New() hash.Hash {
+	return a.new()
+}
+
+// Returns the digest of data.
+func (a *Algorithm) Sum(data stdx.Bytes) stdx.Bytes {
+	hasher := a.new()
+	hasher.Write(data)
+	return stdx.Bytes(hasher.Sum(nil))
+}
+
+func newBlake2b(size int) hash.Hash {
+	hasher, _ := blake2b.New(size, nil)
+	return hasher
+}
+
+// chainedHash applies outer to the digest of inner, e.g. double SHA-256 and Hash160.
+type chainedHash struct {
+	hash.Hash
+	outer func() hash.Hash
+}
+
+func newChained(inner hash.Hash, outer func() hash.Hash) hash.Hash {
+	return &chainedHash{Hash: inner, outer: outer}
+}
+
+func (h *chainedHash) Size() int {
+	return h.outer().Size()
+}
+
+func (h *chainedHash) Sum(b []byte) []byte {
+	outer := h.outer()
+	outer.Write(h.Hash.Sum(nil))
+	return outer.Sum(b)
+}
+
+// taggedHash is SHA-256 prefixed with SHA256(tag) twice following BIP-340.
+type taggedHash struct {
+	hash.Hash
+	tagHash [sha256.Size]byte
+}
+
+func newTagged(tag string) hash.Hash {
+	h := &taggedHash{Hash: sha256.New(), tagHash: sha256.Sum256([]byte(tag))}
+	h.Reset()
+	return h
+}
+
+func (h *taggedHash) Reset() {
+	h.Hash.Reset()
+	h.Hash.Write(h.tagHash[:])
+	h.Hash.Write(h.tagHash[:])
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package hasher
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   []byte
+		digest string
+	}{
+		{"sha256", []byte("abc"), "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{"double-sha256", []byte("abc"), "4f8b42c22dd3729b519ba6f68d2da7cc5b2d606d05daed5ad5128cc03e6c6358"},
+		{"ripemd160", []byte("abc"), "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc"},
+		{"hash160", []byte("abc"), "bb1be98c142444d7a56aa3981c3942a978e4dc33"},
+		{"sha512", []byte("abc"), "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"},
+		{"SHA3-256", []byte("abc"), "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532"},
+		{"sha3-512", []byte("abc"), "b751850b1a57168a5693cd924b6b096e08f621827444f70d884f5d0240d2712e10e116e9192af3c91a7ec57647e3934057340b4cf408d5a56592f8274eec53f0"},
+		{"keccak256", []byte("abc"), "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"},
+		{"keccak512", []byte{}, "0eab42de4c3ceb9235fc91acffe746b29c29a8c366b7c60e4e67c466f36a4304c00fa9caf9d87976ba469bcbe06713b435f091ef2769fb160cdab33d3670680e"},
+		{"blake2b-256", []byte("abc"), "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319"},
+		{"blake2b-512", []byte("abc"), "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923"},
+		{"blake2b-160", []byte("abc"), "384264f676f39536840523f284921cdc68b6846b"},
+		{"blake2s-256", []byte("abc"), "508c5e8c327c14e2e1a72ba34eeb452f37458b209ed63a294d999b4c86675982"},
+		{"blake2s-128", []byte("abc"), "aa4938119b1dc7b87cbad0ffd200d0ae"},
+		{"blake2s-160", []byte("abc"), "5ae3b99be29b01834c3b508521ede60438f8de17"},
+		{"blake2s-192", bytes.Repeat(sequence(256), 3), "1534bd426acbe0a7263ebd3d42059399d542505e95599fef"},
+		{"tagged:BIP0340/challenge", []byte("abc"), "770a5b7e7c304bbcc3ea107343ff951dd404312ef418db0c3b94e2ebfbb50087"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			algorithm, err := Get(tt.name)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			digest := algorithm.Sum(tt.data)
+			if digest.HexStr() != tt.digest {
+				t.Errorf("invalid digest. expected %s actual %s", tt.digest, digest.HexStr())
+			}
+			if len(digest) != algorithm.Size || algorithm.New().Size() != algorithm.Size {
+				t.Errorf("invalid size. expected %d actual %d", algorithm.Size, len(digest))
+			}
+			hasher := algorithm.New()
+			hasher.Write([]byte("garbage"))
+			hasher.Reset()
+			hasher.Write(tt.data)
+			if actual := hasher.Sum(nil); !bytes.Equal(actual, digest) {
+				t.Errorf("invalid digest after reset. expected %s actual %x", tt.digest, actual)
+			}
+		})
+	}
+}
+
+func TestGet_Invalid(t *testing.T) {
+	tests := []string{"md5", "blake2b-0", "blake2b-520", "blake2s-264", "blake2s-12", "blake2s-x"}
+	for _, name := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Get(name); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
+
+func sequence(n int) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	return data
+}
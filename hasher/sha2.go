@@ -0,0 +1,50 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package hasher
+
+import (
+	"crypto/sha256"
+
+	"github.com/tforce-io/tf-golib/stdx"
+	"golang.org/x/crypto/ripemd160"
+)
+
+func Sha256(data stdx.Bytes) stdx.Bytes {
+	hash := sha256.Sum256(data)
+	return stdx.Bytes(hash[:])
+}
+
+// Returns SHA-256 applied twice, used by Bitcoin for checksums and transaction ids.
+func DoubleSha256(data stdx.Bytes) stdx.Bytes {
+	first := sha256.Sum256(data)
+	hash := sha256.Sum256(first[:])
+	return stdx.Bytes(hash[:])
+}
+
+func Ripemd160(data stdx.Bytes) stdx.Bytes {
+	hasher := ripemd160.New()
+	hasher.Write(data)
+	hash := hasher.Sum(nil)
+	return stdx.Bytes(hash)
+}
+
+// Returns RIPEMD-160 of SHA-256, used by Bitcoin to hash public keys and scripts.
+func Hash160(data stdx.Bytes) stdx.Bytes {
+	first := sha256.Sum256(data)
+	return Ripemd160(first[:])
+}
+
+// Returns tagged hash defined in BIP-340: SHA256(SHA256(tag) || SHA256(tag) || data).
+func TaggedHash(tag string, data stdx.Bytes) stdx.Bytes {
+	tagHash := sha256.Sum256([]byte(tag))
+	hasher := sha256.New()
+	hasher.Write(tagHash[:])
+	hasher.Write(tagHash[:])
+	hasher.Write(data)
+	hash := hasher.Sum(nil)
+	return stdx.Bytes(hash)
+}
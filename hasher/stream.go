@@ -0,0 +1,56 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package hasher
+
+import (
+	"errors"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+// Returns the digest of all data read from r until EOF.
+func (a *Algorithm) SumReader(r io.Reader) (stdx.Bytes, error) {
+	digests, err := SumReader(r, a)
+	if err != nil {
+		return nil, err
+	}
+	return digests[0], nil
+}
+
+// Returns digests of all data read from r with each of algorithms in one pass over the data.
+func SumReader(r io.Reader, algorithms ...*Algorithm) ([]stdx.Bytes, error) {
+	if len(algorithms) == 0 {
+		return nil, errors.New("at least one algorithm is required")
+	}
+	hashers := make([]hash.Hash, len(algorithms))
+	writers := make([]io.Writer, len(algorithms))
+	for i, algorithm := range algorithms {
+		hashers[i] = algorithm.New()
+		writers[i] = hashers[i]
+	}
+	if _, err := io.Copy(io.MultiWriter(writers...), r); err != nil {
+		return nil, err
+	}
+	digests := make([]stdx.Bytes, len(hashers))
+	for i, hasher := range hashers {
+		digests[i] = stdx.Bytes(hasher.Sum(nil))
+	}
+	return digests, nil
+}
+
+// Returns digests of the file at path with each of algorithms in one pass over the file.
+func SumFile(path string, algorithms ...*Algorithm) ([]stdx.Bytes, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return SumReader(file, algorithms...)
+}
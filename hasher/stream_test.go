@@ -0,0 +1,50 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package hasher
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSumReader(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", []byte{}},
+		{"short", []byte("abc")},
+		{"multiple_blocks", bytes.Repeat(sequence(256), 1000)},
+	}
+	algorithms := []*Algorithm{}
+	for _, name := range append(Names(), "blake2s-160", "tagged:TapLeaf") {
+		algorithm, _ := Get(name)
+		algorithms = append(algorithms, algorithm)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			digests, err := SumReader(bytes.NewReader(tt.data), algorithms...)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			path := filepath.Join(t.TempDir(), "data")
+			os.WriteFile(path, tt.data, 0600)
+			fileDigests, err := SumFile(path, algorithms...)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			for i, algorithm := range algorithms {
+				expected := algorithm.Sum(tt.data)
+				if !bytes.Equal(digests[i], expected) || !bytes.Equal(fileDigests[i], expected) {
+					t.Errorf("invalid %s digest. expected %s actual %s %s", algorithm.Name, expected.HexStr(), digests[i].HexStr(), fileDigests[i].HexStr())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	tests := []struct {
+		name     string
+		dir      string
+		existing bool
+		isValid  bool
+	}{
+		{"new_file", "", false, true},
+		{"overwrite", "", true, true},
+		{"missing_dir", "missing", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), tt.dir)
+			path := filepath.Join(dir, "file.json")
+			if tt.existing {
+				os.WriteFile(path, []byte("old"), 0644)
+			}
+			err := WriteFileAtomic(path, []byte("new"))
+			if !tt.isValid {
+				if err == nil {
+					t.Errorf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			data, _ := os.ReadFile(path)
+			if string(data) != "new" {
+				t.Errorf("invalid content. expected %s actual %s", "new", data)
+			}
+			if info, _ := os.Stat(path); info.Mode().Perm() != 0600 {
+				t.Errorf("invalid mode %v", info.Mode().Perm())
+			}
+			if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+				t.Errorf("temporary file is not removed")
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package fileutil provides file helpers shared by other packages of CryptoTool.
+*/
+package fileutil
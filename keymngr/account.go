@@ -7,7 +7,10 @@
 package keymngr
 
 import (
+	"fmt"
+
 	"github.com/lukaz17/cryptotool-go/hasher"
+	"github.com/lukaz17/cryptotool-go/secmem"
 	"github.com/tforce-io/tf-golib/stdx"
 )
 
@@ -59,7 +62,7 @@ func (a *EthereumAccount) DerivationPath() string {
 
 // Returns the mnemonic linked to underlying keypair.
 func (a *EthereumAccount) Mnemonic() string {
-	return a.keypair.mnemonic
+	return a.keypair.Mnemonic()
 }
 
 // Returns the private key of underlying keypair.
@@ -69,7 +72,9 @@ func (a *EthereumAccount) PrivateKey() stdx.Bytes {
 
 // Returns the private key of underlying keypair in 0x hex string.
 func (a *EthereumAccount) PrivateKeyStr() string {
-	hexStr := stdx.NewHex(a.keypair.PrivateKey(), true)
+	privateKey := a.keypair.PrivateKey()
+	defer secmem.Wipe(privateKey)
+	hexStr := stdx.NewHex(privateKey, true)
 	return hexStr.Value()
 }
 
@@ -94,3 +99,22 @@ func (a *EthereumAccount) UncompressPublicKeyStr() string {
 	hexStr := stdx.NewHex(a.keypair.UncompressPublicKey(), true)
 	return hexStr.Value()
 }
+
+// Wipes the secrets of underlying keypair from memory. The account must not be used
+// after Destroy.
+func (a *EthereumAccount) Destroy() {
+	a.keypair.Destroy()
+}
+
+// Returns the address of the account, the private key and mnemonic are redacted.
+func (a *EthereumAccount) String() string {
+	if a.keypair.IsDestroyed() {
+		return "EthereumAccount{destroyed}"
+	}
+	return fmt.Sprintf("EthereumAccount{address: %s, privateKey: %s}", a.AddressStr(), secmem.Redacted)
+}
+
+// Returns the same value as String so secrets are also redacted when formatted with %#v.
+func (a *EthereumAccount) GoString() string {
+	return a.String()
+}
@@ -0,0 +1,239 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tforce-io/tf-golib/stdx"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+const (
+	bip85Purpose     = 83696968
+	bip85HMACKey     = "bip-entropy-from-k"
+	bip85AppBIP39    = 39
+	bip85AppWIF      = 2
+	bip85AppHex      = 128169
+	bip85AppBase64   = 707764
+	bip85AppBase85   = 707785
+	base85Characters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz!#$%&()*+-;<=>?@^_`{|}~"
+)
+
+// A Language is a BIP-39 wordlist identified by its BIP-85 language code.
+type Language uint32
+
+const (
+	LanguageEnglish            Language = 0
+	LanguageJapanese           Language = 1
+	LanguageKorean             Language = 2
+	LanguageSpanish            Language = 3
+	LanguageChineseSimplified  Language = 4
+	LanguageChineseTraditional Language = 5
+	LanguageFrench             Language = 6
+	LanguageItalian            Language = 7
+	LanguageCzech              Language = 8
+)
+
+var languageWordlists = map[Language][]string{
+	LanguageEnglish:            wordlists.English,
+	LanguageJapanese:           wordlists.Japanese,
+	LanguageKorean:             wordlists.Korean,
+	LanguageSpanish:            wordlists.Spanish,
+	LanguageChineseSimplified:  wordlists.ChineseSimplified,
+	LanguageChineseTraditional: wordlists.ChineseTraditional,
+	LanguageFrench:             wordlists.French,
+	LanguageItalian:            wordlists.Italian,
+	LanguageCzech:              wordlists.Czech,
+}
+
+// A BIP85 derives independent child entropy from a master key following BIP-85 specification,
+// so one backup of the master mnemonic covers many wallets and passwords.
+type BIP85 struct {
+	master *bip32.Key
+}
+
+// Returns a BIP85 deriving from a copy of the master key. Call Destroy after use.
+func NewBIP85(master *bip32.Key) *BIP85 {
+	key := *master
+	key.Key = append([]byte{}, master.Key...)
+	key.ChainCode = append([]byte{}, master.ChainCode...)
+	return &BIP85{master: &key}
+}
+
+// Returns a BIP85 deriving from the master key of mnemonic and password. Call Destroy after use.
+func NewBIP85FromMnemonic(mnemonic, password string) (*BIP85, error) {
+	master, err := DeriveKeyFromMnemonic(mnemonic, password, "m")
+	if err != nil {
+		return nil, err
+	}
+	return &BIP85{master: master}, nil
+}
+
+// Wipes the master key. It is safe to call Destroy more than once.
+func (b *BIP85) Destroy() {
+	WipeKey(b.master)
+}
+
+// Returns 64 bytes of entropy derived at derivationPath, which must only contain hardened indexes.
+// The entropy is HMAC-SHA512 of the derived private key with key "bip-entropy-from-k".
+func (b *BIP85) Entropy(derivationPath string) (stdx.Bytes, error) {
+	path, err := ParseDerivationPath(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		return nil, errors.New("derivation path must not be empty")
+	}
+	for _, part := range path {
+		if !part.IsHarden {
+			return nil, errors.New("derivation path must only contain hardened indexes")
+		}
+	}
+	key, err := deriveChildKey(b.master, path)
+	if err != nil {
+		return nil, err
+	}
+	defer WipeKey(key)
+	privateKey := padBytes(key.Key, 32)
+	defer secmem.Wipe(privateKey)
+	mac := hmac.New(sha512.New, []byte(bip85HMACKey))
+	mac.Write(privateKey)
+	return mac.Sum(nil), nil
+}
+
+// Returns the child mnemonic of words words in language at index.
+// words must be 12, 15, 18, 21 or 24.
+func (b *BIP85) Mnemonic(language Language, words, index uint32) (string, error) {
+	wordlist, ok := languageWordlists[language]
+	if !ok {
+		return "", fmt.Errorf("unsupported language %d", language)
+	}
+	if words < 12 || words > 24 || words%3 != 0 {
+		return "", errors.New("number of words must be 12, 15, 18, 21 or 24")
+	}
+	entropy, err := b.entropyOf(bip85AppBIP39, uint32(language), words, index)
+	if err != nil {
+		return "", err
+	}
+	defer secmem.Wipe(entropy)
+	return encodeMnemonic(entropy[:words*4/3], wordlist, language), nil
+}
+
+// Returns the private key in wallet import format for compressed public key at index.
+func (b *BIP85) WIF(index uint32) (string, error) {
+	entropy, err := b.entropyOf(bip85AppWIF, index)
+	if err != nil {
+		return "", err
+	}
+	defer secmem.Wipe(entropy)
+	account := NewBitcoinAccount(NewSecp256k1Keypair(entropy[:32]), BitcoinMainnet)
+	defer account.Destroy()
+	return account.PrivateKeyWIF(), nil
+}
+
+// Returns numBytes bytes of raw entropy at index. numBytes must be between 16 and 64.
+func (b *BIP85) Hex(numBytes, index uint32) (stdx.Bytes, error) {
+	if numBytes < 16 || numBytes > 64 {
+		return nil, errors.New("number of bytes must be between 16 and 64")
+	}
+	entropy, err := b.entropyOf(bip85AppHex, numBytes, index)
+	if err != nil {
+		return nil, err
+	}
+	return entropy[:numBytes], nil
+}
+
+// Returns a password of length characters from base64 encoding of entropy at index.
+// length must be between 20 and 86.
+func (b *BIP85) PasswordBase64(length, index uint32) (string, error) {
+	if length < 20 || length > 86 {
+		return "", errors.New("password length must be between 20 and 86")
+	}
+	entropy, err := b.entropyOf(bip85AppBase64, length, index)
+	if err != nil {
+		return "", err
+	}
+	defer secmem.Wipe(entropy)
+	return base64.StdEncoding.EncodeToString(entropy)[:length], nil
+}
+
+// Returns a password of length characters from base85 encoding of entropy at index,
+// using the RFC 1924 character set. length must be between 10 and 80.
+func (b *BIP85) PasswordBase85(length, index uint32) (string, error) {
+	if length < 10 || length > 80 {
+		return "", errors.New("password length must be between 10 and 80")
+	}
+	entropy, err := b.entropyOf(bip85AppBase85, length, index)
+	if err != nil {
+		return "", err
+	}
+	defer secmem.Wipe(entropy)
+	return encodeBase85(entropy)[:length], nil
+}
+
+// Returns the entropy of application app with hardened indexes.
+func (b *BIP85) entropyOf(app uint32, indexes ...uint32) (stdx.Bytes, error) {
+	var path strings.Builder
+	fmt.Fprintf(&path, "m/%d'/%d'", bip85Purpose, app)
+	for _, index := range indexes {
+		if index >= bip32.FirstHardenedChild {
+			return nil, fmt.Errorf("index %d is out of range", index)
+		}
+		fmt.Fprintf(&path, "/%d'", index)
+	}
+	return b.Entropy(path.String())
+}
+
+// Returns the BIP-39 mnemonic of entropy using wordlist. Japanese words are separated
+// by ideographic spaces as required by BIP-39.
+func encodeMnemonic(entropy []byte, wordlist []string, language Language) string {
+	checksumBits := uint(len(entropy) / 4)
+	hash := sha256.Sum256(entropy)
+	value := new(big.Int).SetBytes(entropy)
+	value.Lsh(value, checksumBits).Or(value, big.NewInt(int64(hash[0]>>(8-checksumBits))))
+	words := make([]string, (len(entropy)*8+int(checksumBits))/11)
+	mask := big.NewInt(2047)
+	index := new(big.Int)
+	for i := len(words) - 1; i >= 0; i-- {
+		words[i] = wordlist[index.And(value, mask).Int64()]
+		value.Rsh(value, 11)
+	}
+	separator := " "
+	if language == LanguageJapanese {
+		separator = "\u3000"
+	}
+	return strings.Join(words, separator)
+}
+
+// Returns base85 encoding of data following RFC 1924 character set. Data is padded with zeros
+// to a multiple of 4 bytes and characters of the padding are removed.
+func encodeBase85(data []byte) string {
+	padding := (4 - len(data)%4) % 4
+	padded := append(append(make([]byte, 0, len(data)+padding), data...), make([]byte, padding)...)
+	defer secmem.Wipe(padded)
+	result := make([]byte, 0, len(padded)/4*5)
+	for i := 0; i < len(padded); i += 4 {
+		value := uint32(padded[i])<<24 | uint32(padded[i+1])<<16 | uint32(padded[i+2])<<8 | uint32(padded[i+3])
+		var chunk [5]byte
+		for j := 4; j >= 0; j-- {
+			chunk[j] = base85Characters[value%85]
+			value /= 85
+		}
+		result = append(result, chunk[:]...)
+	}
+	return string(result[:len(result)-padding])
+}
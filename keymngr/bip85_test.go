@@ -0,0 +1,98 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/go-bip32"
+)
+
+const bip85MasterKey = "xprv9s21ZrQH143K2LBWUUQRFXhucrQqBpKdRRxNVq2zBqsx8HVqFk2uYo8kmbaLLHRdqtQpUm98uKfu3vca1LqdGhUtyoFnCNkfmXRyPXLjbKb"
+
+func TestBIP85_Entropy(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		entropy string
+	}{
+		{"case_1", "m/83696968'/0'/0'", "efecfbccffea313214232d29e71563d941229afb4338c21f9517c41aaa0d16f00b83d2a09ef747e7a64e8e2bd5a14869e693da66ce94ac2da570ab7ee48618f7"},
+		{"case_2", "m/83696968'/0'/1'", "70c6e3e8ebee8dc4c0dbba66076819bb8c09672527c4277ca8729532ad711872218f826919f6b67218adde99018a6df9095ab2b58d803b5b93ec9802085a690e"},
+	}
+	bip85 := newTestBIP85(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entropy, err := bip85.Entropy(tt.path)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if entropy.HexStr() != tt.entropy {
+				t.Errorf("invalid entropy. expected %s actual %s", tt.entropy, entropy.HexStr())
+			}
+		})
+	}
+}
+
+func TestBIP85_Mnemonic(t *testing.T) {
+	tests := []struct {
+		name     string
+		words    uint32
+		mnemonic string
+	}{
+		{"12_words", 12, "girl mad pet galaxy egg matter matrix prison refuse sense ordinary nose"},
+		{"18_words", 18, "near account window bike charge season chef number sketch tomorrow excuse sniff circle vital hockey outdoor supply token"},
+		{"24_words", 24, "puppy ocean match cereal symbol another shed magic wrap hammer bulb intact gadget divorce twin tonight reason outdoor destroy simple truth cigar social volcano"},
+	}
+	bip85 := newTestBIP85(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mnemonic, err := bip85.Mnemonic(LanguageEnglish, tt.words, 0)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if mnemonic != tt.mnemonic {
+				t.Errorf("invalid mnemonic. expected %s actual %s", tt.mnemonic, mnemonic)
+			}
+		})
+	}
+}
+
+func TestBIP85_Applications(t *testing.T) {
+	bip85 := newTestBIP85(t)
+	tests := []struct {
+		name     string
+		derive   func() (string, error)
+		expected string
+	}{
+		{"wif", func() (string, error) { return bip85.WIF(0) }, "Kzyv4uF39d4Jrw2W7UryTHwZr1zQVNk4dAFyqE6BuMrMh1Za7uhp"},
+		{"hex", func() (string, error) {
+			entropy, err := bip85.Hex(64, 0)
+			return entropy.HexStr(), err
+		}, "492db4698cf3b73a5a24998aa3e9d7fa96275d85724a91e71aa2d645442f878555d078fd1f1f67e368976f04137b1f7a0d19232136ca50c44614af72b5582a5c"},
+		{"password_base64", func() (string, error) { return bip85.PasswordBase64(21, 0) }, "dKLoepugzdVJvdL56ogNV"},
+		{"password_base85", func() (string, error) { return bip85.PasswordBase85(12, 0) }, "_s`{TW89)i4`"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.derive()
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if actual != tt.expected {
+				t.Errorf("invalid result. expected %s actual %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func newTestBIP85(t *testing.T) *BIP85 {
+	master, err := bip32.B58Deserialize(bip85MasterKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewBIP85(master)
+}
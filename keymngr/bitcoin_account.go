@@ -0,0 +1,209 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"runtime"
+	"strings"
+
+	btcutil "github.com/FactomProject/btcutilecc"
+	"github.com/lukaz17/cryptotool-go/base58"
+	"github.com/lukaz17/cryptotool-go/bech32"
+	"github.com/lukaz17/cryptotool-go/hasher"
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+// A BitcoinNetwork contains version bytes and human-readable part used to encode
+// addresses and private keys of a Bitcoin network.
+type BitcoinNetwork struct {
+	Name              string
+	PubKeyHashVersion byte
+	PrivateKeyVersion byte
+	Bech32HRP         string
+}
+
+var (
+	BitcoinMainnet = &BitcoinNetwork{
+		Name:              "mainnet",
+		PubKeyHashVersion: 0x00,
+		PrivateKeyVersion: 0x80,
+		Bech32HRP:         "bc",
+	}
+	BitcoinTestnet = &BitcoinNetwork{
+		Name:              "testnet",
+		PubKeyHashVersion: 0x6f,
+		PrivateKeyVersion: 0xef,
+		Bech32HRP:         "tb",
+	}
+)
+
+// Returns the BitcoinNetwork from its name, case-insensitive.
+func ParseBitcoinNetwork(name string) (*BitcoinNetwork, error) {
+	for _, network := range []*BitcoinNetwork{BitcoinMainnet, BitcoinTestnet} {
+		if strings.EqualFold(name, network.Name) {
+			return network, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown bitcoin network %s", name)
+}
+
+// A BitcoinAddressType is the script type an address pays to.
+type BitcoinAddressType int
+
+const (
+	// Legacy pay-to-pubkey-hash address in Base58Check, starting with 1 on mainnet.
+	P2PKH BitcoinAddressType = iota
+	// Native segwit pay-to-witness-pubkey-hash address in Bech32, starting with bc1q on mainnet.
+	P2WPKH
+	// Taproot key path address in Bech32m following BIP-86, starting with bc1p on mainnet.
+	P2TR
+)
+
+// Returns the name of address type.
+func (t BitcoinAddressType) String() string {
+	switch t {
+	case P2PKH:
+		return "p2pkh"
+	case P2WPKH:
+		return "p2wpkh"
+	case P2TR:
+		return "p2tr"
+	}
+	return fmt.Sprintf("BitcoinAddressType(%d)", int(t))
+}
+
+// Returns BitcoinAddressType from its name, case-insensitive.
+func ParseBitcoinAddressType(name string) (BitcoinAddressType, error) {
+	switch strings.ToLower(name) {
+	case "p2pkh", "legacy":
+		return P2PKH, nil
+	case "p2wpkh", "segwit":
+		return P2WPKH, nil
+	case "p2tr", "taproot":
+		return P2TR, nil
+	}
+	return 0, fmt.Errorf("unknown bitcoin address type %s", name)
+}
+
+// A BitcoinAccount derive addresses and private key in wallet import format based on
+// Secp256k1Keypair. Addresses always use compressed public key.
+type BitcoinAccount struct {
+	keypair *Secp256k1Keypair
+	network *BitcoinNetwork
+}
+
+// Returns a BitcoinAccount of a Secp256k1Keypair on network.
+func NewBitcoinAccount(keypair *Secp256k1Keypair, network *BitcoinNetwork) *BitcoinAccount {
+	return &BitcoinAccount{
+		keypair: keypair,
+		network: network,
+	}
+}
+
+// Returns the address of addressType.
+func (a *BitcoinAccount) Address(addressType BitcoinAddressType) string {
+	address, _ := BitcoinAddressFromPublicKey(a.keypair.PublicKey(), addressType, a.network)
+	return address
+}
+
+// Returns the legacy P2PKH address.
+func (a *BitcoinAccount) P2PKHAddress() string {
+	return a.Address(P2PKH)
+}
+
+// Returns the native segwit P2WPKH address.
+func (a *BitcoinAccount) P2WPKHAddress() string {
+	return a.Address(P2WPKH)
+}
+
+// Returns the taproot P2TR address without script path.
+func (a *BitcoinAccount) P2TRAddress() string {
+	return a.Address(P2TR)
+}
+
+// Returns the private key in wallet import format for compressed public key.
+func (a *BitcoinAccount) PrivateKeyWIF() string {
+	data := make([]byte, 0, 34)
+	defer secmem.Wipe(data[:cap(data)])
+	data = append(data, a.network.PrivateKeyVersion)
+	data = append(data, padBytes(a.keypair.privateKey.Bytes(), 32)...)
+	runtime.KeepAlive(a.keypair.privateKey)
+	data = append(data, 0x01)
+	return base58.CheckEncode(data)
+}
+
+// Returns the compressed public key.
+func (a *BitcoinAccount) PublicKey() stdx.Bytes {
+	return a.keypair.PublicKey()
+}
+
+// Wipes the secrets of underlying keypair from memory. The account must not be used
+// after Destroy.
+func (a *BitcoinAccount) Destroy() {
+	a.keypair.Destroy()
+}
+
+// Returns the native segwit address of the account, the private key is redacted.
+func (a *BitcoinAccount) String() string {
+	if a.keypair.IsDestroyed() {
+		return "BitcoinAccount{destroyed}"
+	}
+	return fmt.Sprintf("BitcoinAccount{network: %s, address: %s, privateKey: %s}", a.network.Name, a.P2WPKHAddress(), secmem.Redacted)
+}
+
+// Returns the same value as String so secrets are also redacted when formatted with %#v.
+func (a *BitcoinAccount) GoString() string {
+	return a.String()
+}
+
+// Returns the address of addressType for a compressed public key on network.
+func BitcoinAddressFromPublicKey(publicKey stdx.Bytes, addressType BitcoinAddressType, network *BitcoinNetwork) (string, error) {
+	if len(publicKey) != Secp256k1PointLength+1 {
+		return "", errors.New("public key must be compressed")
+	}
+	switch addressType {
+	case P2PKH:
+		data := append([]byte{network.PubKeyHashVersion}, hasher.Hash160(publicKey)...)
+		return base58.CheckEncode(data), nil
+	case P2WPKH:
+		return bech32.EncodeSegwitAddress(network.Bech32HRP, 0, hasher.Hash160(publicKey))
+	case P2TR:
+		outputKey, err := TaprootOutputKey(publicKey)
+		if err != nil {
+			return "", err
+		}
+		return bech32.EncodeSegwitAddress(network.Bech32HRP, 1, outputKey)
+	}
+	return "", fmt.Errorf("unsupported address type %s", addressType)
+}
+
+// Returns the x-only taproot output key of an internal public key without script path
+// following BIP-86: Q = P + H_TapTweak(x(P))*G where P has even y.
+func TaprootOutputKey(publicKey stdx.Bytes) (stdx.Bytes, error) {
+	x, _, err := ParsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	curve := btcutil.Secp256k1()
+	// Lift x to the point with even y as required by BIP-340.
+	y, err := decompressY(x, 0)
+	if err != nil {
+		return nil, err
+	}
+	xOnly := padBytes(x.Bytes(), Secp256k1PointLength)
+	tweak := hasher.TaggedHash("TapTweak", xOnly)
+	if new(big.Int).SetBytes(tweak).Cmp(curve.Params().N) >= 0 {
+		return nil, errors.New("invalid taproot tweak")
+	}
+	tx, ty := curve.ScalarBaseMult(tweak)
+	qx, _ := curve.Add(x, y, tx, ty)
+	return stdx.Bytes(padBytes(qx.Bytes(), Secp256k1PointLength)), nil
+}
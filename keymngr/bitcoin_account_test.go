@@ -0,0 +1,56 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestBitcoinAccount(t *testing.T) {
+	// Test cases are generated using btcd
+	tests := []struct {
+		name       string
+		privateKey string
+		network    *BitcoinNetwork
+		p2pkh      string
+		p2wpkh     string
+		p2tr       string
+		wif        string
+	}{
+		{"one_mainnet", "0000000000000000000000000000000000000000000000000000000000000001", BitcoinMainnet,
+			"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			"bc1pmfr3p9j00pfxjh0zmgp99y8zftmd3s5pmedqhyptwy6lm87hf5sspknck9", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn"},
+		{"one_testnet", "0000000000000000000000000000000000000000000000000000000000000001", BitcoinTestnet,
+			"mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+			"tb1pmfr3p9j00pfxjh0zmgp99y8zftmd3s5pmedqhyptwy6lm87hf5ssk79hv2", "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA"},
+		{"odd_y_mainnet", "4646464646464646464646464646464646464646464646464646464646464646", BitcoinMainnet,
+			"1JHMeqKunF2Up6zxnMQGhJu5667BXz98YQ", "bc1qhkfq3zahaqkkzx5mjnamwjsfpq2jk7z00ppggv",
+			"bc1pcflwn3g4de9mvrfyhtv5ujxcq664r69wd3alhpj98j9c0wfuj5aqq3zavz", "KyaKF5JS1JqP8iGWt6LXyzYjPsbTjPK5BCUajn9aNp788rhK19nq"},
+		{"bip32_mainnet", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35", BitcoinMainnet,
+			"15mKKb2eos1hWa6tisdPwwDC1a5J1y9nma", "bc1qx3ppj0smkuy3d6g525sh9n2w9k7fm7q3x30rtg",
+			"bc1p7x4krdxtfwv5mwu2hhq6y2pnp5msc8mnczk5rr06zsulrn395zzq78ql6s", "L52XzL2cMkHxqxBXRyEpnPQZGUs3uKiL3R11XbAdHigRzDozKZeW"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKey, _ := hex.DecodeString(tt.privateKey)
+			account := NewBitcoinAccount(NewSecp256k1Keypair(privateKey), tt.network)
+			if account.P2PKHAddress() != tt.p2pkh {
+				t.Errorf("invalid p2pkh address. expected %s actual %s", tt.p2pkh, account.P2PKHAddress())
+			}
+			if account.P2WPKHAddress() != tt.p2wpkh {
+				t.Errorf("invalid p2wpkh address. expected %s actual %s", tt.p2wpkh, account.P2WPKHAddress())
+			}
+			if account.P2TRAddress() != tt.p2tr {
+				t.Errorf("invalid p2tr address. expected %s actual %s", tt.p2tr, account.P2TRAddress())
+			}
+			if account.PrivateKeyWIF() != tt.wif {
+				t.Errorf("invalid wif. expected %s actual %s", tt.wif, account.PrivateKeyWIF())
+			}
+		})
+	}
+}
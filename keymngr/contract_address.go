@@ -0,0 +1,93 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"errors"
+
+	"github.com/lukaz17/cryptotool-go/hasher"
+	"github.com/lukaz17/cryptotool-go/rlp"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+// Returns the address bytes of a contract deployed by deployer at nonce using CREATE opcode.
+// The address is the last 20 bytes of Keccak256(RLP([deployer, nonce])).
+func ContractAddress(deployer stdx.Bytes, nonce uint64) (stdx.Bytes, error) {
+	if len(deployer) != 20 {
+		return nil, errors.New("deployer address must be 20 bytes")
+	}
+	encoded, err := rlp.Encode([]interface{}{deployer, nonce})
+	if err != nil {
+		return nil, err
+	}
+	hash := hasher.Keccak256(encoded)
+	return stdx.Bytes(hash[12:]), nil
+}
+
+// Returns the EIP-55 checksum address of a contract deployed by deployer at nonce using CREATE opcode.
+func ContractAddressStr(deployer string, nonce uint64) (string, error) {
+	deployerBytes, err := decodeHexField("deployer", deployer, 20)
+	if err != nil {
+		return "", err
+	}
+	address, err := ContractAddress(deployerBytes, nonce)
+	if err != nil {
+		return "", err
+	}
+	return CreateChecksumAddress(stdx.NewHex(address, true).Value(), nil)
+}
+
+// Returns the address bytes of a contract deployed by deployer using CREATE2 opcode
+// following EIP-1014 specification.
+// The address is the last 20 bytes of Keccak256(0xff ++ deployer ++ salt ++ initCodeHash).
+func Create2Address(deployer, salt, initCodeHash stdx.Bytes) (stdx.Bytes, error) {
+	if len(deployer) != 20 {
+		return nil, errors.New("deployer address must be 20 bytes")
+	}
+	if len(salt) != 32 {
+		return nil, errors.New("salt must be 32 bytes")
+	}
+	if len(initCodeHash) != 32 {
+		return nil, errors.New("init code hash must be 32 bytes")
+	}
+	data := make([]byte, 0, 85)
+	data = append(data, 0xff)
+	data = append(data, deployer...)
+	data = append(data, salt...)
+	data = append(data, initCodeHash...)
+	hash := hasher.Keccak256(data)
+	return stdx.Bytes(hash[12:]), nil
+}
+
+// Returns the EIP-55 checksum address of a contract deployed by deployer using CREATE2 opcode
+// following EIP-1014 specification. All inputs are hex strings with optional 0x prefix.
+func Create2AddressStr(deployer, salt, initCodeHash string) (string, error) {
+	deployerBytes, err := decodeHexField("deployer", deployer, 20)
+	if err != nil {
+		return "", err
+	}
+	saltBytes, err := decodeHexField("salt", salt, 32)
+	if err != nil {
+		return "", err
+	}
+	initCodeHashBytes, err := decodeHexField("initCodeHash", initCodeHash, 32)
+	if err != nil {
+		return "", err
+	}
+	address, err := Create2Address(deployerBytes, saltBytes, initCodeHashBytes)
+	if err != nil {
+		return "", err
+	}
+	return CreateChecksumAddress(stdx.NewHex(address, true).Value(), nil)
+}
+
+// Returns the EIP-55 checksum address of a contract deployed by this account at nonce using CREATE opcode.
+func (a *EthereumAccount) ContractAddress(nonce uint64) string {
+	address, _ := ContractAddress(a.Address(), nonce)
+	checksumAddress, _ := CreateChecksumAddress(stdx.NewHex(address, true).Value(), nil)
+	return checksumAddress
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/lukaz17/cryptotool-go/hasher"
+)
+
+func TestContractAddressStr(t *testing.T) {
+	// Test cases are generated from go-ethereum v1.14.12
+	tests := []struct {
+		name     string
+		deployer string
+		nonce    uint64
+		expected string
+	}{
+		{"nonce_zero", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 0, "0xcd234A471b72ba2F1Ccf0A70FCABA648a5eeCD8d"},
+		{"nonce_one", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 1, "0x343c43A37D37dfF08AE8C4A11544c718AbB4fCF8"},
+		{"nonce_two", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 2, "0xf778B86FA74E846c4f0a1fBd1335FE81c00a0C91"},
+		{"nonce_single_byte_max", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 127, "0x06d9a77f5E4b311Bae8D559DB9CDB4dF94104aA0"},
+		{"nonce_single_byte_string", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 128, "0x08e190dcB7b73F5fcDAbb43e102215c83659A76D"},
+		{"nonce_two_bytes", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 256, "0x3837C1Ae70354f670550C746580199Ac6a73Cb0a"},
+		{"nonce_five_bytes", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 1 << 32, "0xf4bf328880432064068338F915C49f817dC4Ce18"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, err := ContractAddressStr(tt.deployer, tt.nonce)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if address != tt.expected {
+				t.Errorf("invalid contract address. expected %s actual %s", tt.expected, address)
+			}
+		})
+	}
+}
+
+func TestCreate2AddressStr(t *testing.T) {
+	// Test cases are referenced from https://eips.ethereum.org/EIPS/eip-1014
+	tests := []struct {
+		name     string
+		deployer string
+		salt     string
+		initCode string
+		expected string
+	}{
+		{"example_0", "0x0000000000000000000000000000000000000000", "0x0000000000000000000000000000000000000000000000000000000000000000", "00",
+			"0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+		{"example_1", "0xdeadbeef00000000000000000000000000000000", "0x0000000000000000000000000000000000000000000000000000000000000000", "00",
+			"0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+		{"example_2", "0xdeadbeef00000000000000000000000000000000", "0x000000000000000000000000feed000000000000000000000000000000000000", "00",
+			"0xD04116cDd17beBE565EB2422F2497E06cC1C9833"},
+		{"example_3", "0x0000000000000000000000000000000000000000", "0x0000000000000000000000000000000000000000000000000000000000000000", "deadbeef",
+			"0x70f2b2914A2a4b783FaEFb75f459A580616Fcb5e"},
+		{"example_4", "0x00000000000000000000000000000000deadbeef", "0x00000000000000000000000000000000000000000000000000000000cafebabe", "deadbeef",
+			"0x60f3f640a8508fC6a86d45DF051962668E1e8AC7"},
+		{"example_5", "0x00000000000000000000000000000000deadbeef", "0x00000000000000000000000000000000000000000000000000000000cafebabe", strings.Repeat("deadbeef", 11),
+			"0x1d8bfDC5D46DC4f61D6b6115972536eBE6A8854C"},
+		{"example_6", "0x0000000000000000000000000000000000000000", "0x0000000000000000000000000000000000000000000000000000000000000000", "",
+			"0xE33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initCode, _ := hex.DecodeString(tt.initCode)
+			initCodeHash := hasher.Keccak256(initCode).HexStr()
+			address, err := Create2AddressStr(tt.deployer, tt.salt, initCodeHash)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if address != tt.expected {
+				t.Errorf("invalid contract address. expected %s actual %s", tt.expected, address)
+			}
+		})
+	}
+}
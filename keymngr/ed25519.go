@@ -0,0 +1,143 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"runtime"
+
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tforce-io/tf-golib/stdx"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	ed25519SeedKey = "ed25519 seed"
+)
+
+// Ed25519Keypair struct implements key management based on Ed25519 curve.
+// The private key is the 32-byte seed defined in RFC 8032, kept in locked memory until Destroy is called.
+// Formatting a keypair never prints its secrets.
+type Ed25519Keypair struct {
+	privateKey     *secmem.Buffer
+	derivationPath string
+}
+
+// Returns a new Ed25519Keypair from a 32-byte private key seed along with its derivationPath.
+// privateKey is copied, callers should wipe it with secmem.Wipe if it is no longer needed.
+func NewEd25519Keypair(privateKey stdx.Bytes, derivationPath string) (*Ed25519Keypair, error) {
+	if len(privateKey) != ed25519.SeedSize {
+		return nil, fmt.Errorf("ed25519 private key must be %d bytes", ed25519.SeedSize)
+	}
+	return &Ed25519Keypair{
+		privateKey:     secmem.NewBufferFrom(privateKey),
+		derivationPath: derivationPath,
+	}, nil
+}
+
+// Wipes the private key from memory. The keypair must not be used after Destroy.
+// It is safe to call Destroy more than once.
+func (p *Ed25519Keypair) Destroy() {
+	p.privateKey.Destroy()
+}
+
+// Returns true if the keypair has been destroyed.
+func (p *Ed25519Keypair) IsDestroyed() bool {
+	return p.privateKey.IsDestroyed()
+}
+
+// Returns the public key of the keypair, the private key is redacted.
+func (p *Ed25519Keypair) String() string {
+	if p.IsDestroyed() {
+		return "Ed25519Keypair{destroyed}"
+	}
+	return fmt.Sprintf("Ed25519Keypair{publicKey: 0x%s, privateKey: %s}", p.PublicKey().HexStr(), secmem.Redacted)
+}
+
+// Returns the same value as String so secrets are also redacted when formatted with %#v.
+func (p *Ed25519Keypair) GoString() string {
+	return p.String()
+}
+
+// Returns the derivation path linked to this keypair.
+func (p *Ed25519Keypair) DerivationPath() string {
+	return p.derivationPath
+}
+
+// Returns a copy of the 32-byte private key seed.
+// The copy is not locked, callers should wipe it with secmem.Wipe after use.
+func (p *Ed25519Keypair) PrivateKey() stdx.Bytes {
+	privateKey := append(stdx.Bytes{}, p.privateKey.Bytes()...)
+	runtime.KeepAlive(p.privateKey)
+	return privateKey
+}
+
+// Returns the 32-byte public key.
+func (p *Ed25519Keypair) PublicKey() stdx.Bytes {
+	privateKey := ed25519.NewKeyFromSeed(p.privateKey.Bytes())
+	runtime.KeepAlive(p.privateKey)
+	defer secmem.Wipe(privateKey)
+	return append(stdx.Bytes{}, privateKey[ed25519.SeedSize:]...)
+}
+
+// Returns the Ed25519 signature of message.
+func (p *Ed25519Keypair) Sign(message []byte) stdx.Bytes {
+	privateKey := ed25519.NewKeyFromSeed(p.privateKey.Bytes())
+	runtime.KeepAlive(p.privateKey)
+	defer secmem.Wipe(privateKey)
+	return stdx.Bytes(ed25519.Sign(privateKey, message))
+}
+
+// Returns the Ed25519 private key derived from mnemonic following SLIP-10 specification.
+// Callers should wipe the returned key with secmem.Wipe after use.
+func DeriveEd25519KeyFromMnemonic(mnemonic, password, derivationPath string) (stdx.Bytes, error) {
+	if _, err := ParseDerivationPath(derivationPath); err != nil {
+		return nil, err
+	}
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, password)
+	if err != nil {
+		return nil, err
+	}
+	defer secmem.Wipe(seed)
+	return DeriveEd25519KeyFromSeed(seed, derivationPath)
+}
+
+// Returns the Ed25519 private key derived from seed following SLIP-10 specification.
+// Ed25519 only supports hardened derivation, so every index of derivationPath must be hardened.
+// Intermediate keys are wiped, callers should wipe the returned key with secmem.Wipe after use.
+func DeriveEd25519KeyFromSeed(seed []byte, derivationPath string) (stdx.Bytes, error) {
+	path, err := ParseDerivationPath(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, part := range path {
+		if !part.IsHarden {
+			return nil, errors.New("ed25519 only supports hardened derivation")
+		}
+	}
+	mac := hmac.New(sha512.New, []byte(ed25519SeedKey))
+	mac.Write(seed)
+	digest := mac.Sum(nil)
+	for _, part := range path {
+		data := make([]byte, 37)
+		copy(data[1:], digest[:32])
+		binary.BigEndian.PutUint32(data[33:], part.Index+bip32.FirstHardenedChild)
+		mac := hmac.New(sha512.New, digest[32:])
+		mac.Write(data)
+		secmem.Wipe(data)
+		secmem.Wipe(digest)
+		digest = mac.Sum(nil)
+	}
+	defer secmem.Wipe(digest[32:])
+	return stdx.Bytes(digest[:32]), nil
+}
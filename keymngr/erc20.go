@@ -0,0 +1,46 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/lukaz17/cryptotool-go/hasher"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+// Returns the 4-byte function selector of a Solidity function signature, e.g. transfer(address,uint256).
+func FunctionSelector(signature string) stdx.Bytes {
+	return stdx.Bytes(hasher.Keccak256([]byte(signature))[:4])
+}
+
+// Returns the call data of ERC-20 transfer(to, amount).
+func ERC20TransferData(to stdx.Bytes, amount *big.Int) (stdx.Bytes, error) {
+	return encodeAddressUint256Call("transfer(address,uint256)", to, amount)
+}
+
+// Returns the call data of ERC-20 approve(spender, amount).
+// Use zero amount to revoke an allowance.
+func ERC20ApproveData(spender stdx.Bytes, amount *big.Int) (stdx.Bytes, error) {
+	return encodeAddressUint256Call("approve(address,uint256)", spender, amount)
+}
+
+// Returns ABI encoded call data of a function taking an address and an uint256.
+func encodeAddressUint256Call(signature string, address stdx.Bytes, amount *big.Int) (stdx.Bytes, error) {
+	if len(address) != 20 {
+		return nil, errors.New("address must be 20 bytes")
+	}
+	if amount == nil || amount.Sign() < 0 || amount.BitLen() > 256 {
+		return nil, errors.New("amount must be an uint256")
+	}
+	data := make([]byte, 0, 68)
+	data = append(data, FunctionSelector(signature)...)
+	data = append(data, padBytes(address, 32)...)
+	data = append(data, padBytes(amount.Bytes(), 32)...)
+	return stdx.Bytes(data), nil
+}
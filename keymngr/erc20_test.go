@@ -0,0 +1,44 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+func TestERC20Data(t *testing.T) {
+	address, _ := hex.DecodeString("114a781017506df34b3ed4c0e6b438889a6eb3f7")
+	tests := []struct {
+		name    string
+		encode  func() ([]byte, error)
+		data    string
+		isValid bool
+	}{
+		{"transfer", func() ([]byte, error) { return ERC20TransferData(address, big.NewInt(1000000)) },
+			"a9059cbb000000000000000000000000114a781017506df34b3ed4c0e6b438889a6eb3f700000000000000000000000000000000000000000000000000000000000f4240", true},
+		{"approve_zero", func() ([]byte, error) { return ERC20ApproveData(address, new(big.Int)) },
+			"095ea7b3000000000000000000000000114a781017506df34b3ed4c0e6b438889a6eb3f70000000000000000000000000000000000000000000000000000000000000000", true},
+		{"invalid_address", func() ([]byte, error) { return ERC20TransferData(address[1:], big.NewInt(1)) }, "", false},
+		{"negative_amount", func() ([]byte, error) { return ERC20TransferData(address, big.NewInt(-1)) }, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.encode()
+			if !tt.isValid {
+				if err == nil {
+					t.Errorf("expected error")
+				}
+				return
+			}
+			if err != nil || hex.EncodeToString(data) != tt.data {
+				t.Errorf("invalid data. expected %s actual %x", tt.data, data)
+			}
+		})
+	}
+}
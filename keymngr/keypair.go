@@ -8,9 +8,13 @@ package keymngr
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"math/big"
+	"runtime"
 
 	btcutil "github.com/FactomProject/btcutilecc"
+	"github.com/lukaz17/cryptotool-go/secmem"
 	"github.com/tforce-io/tf-golib/stdx"
 )
 
@@ -19,18 +23,22 @@ const (
 )
 
 // Secp256k1Keypair struct implements key management based on Secp256k1 elliptic curve.
+// The private key and mnemonic are kept in locked memory until Destroy is called.
+// Formatting a keypair never prints its secrets.
 type Secp256k1Keypair struct {
-	privateKey     stdx.Bytes
-	mnemonic       string
+	privateKey     *secmem.Buffer
+	mnemonic       *secmem.Buffer
 	derivationPath string
 }
 
 // Returns a new Secp256k1Keypair from a private key.
 // If you want to log the mnemonic and derivationPath associated with this private key,
 // please use NewSecp256k1KeypairWithMetadata instead.
+// privateKey is copied, callers should wipe it with secmem.Wipe if it is no longer needed.
 func NewSecp256k1Keypair(privateKey stdx.Bytes) *Secp256k1Keypair {
 	return &Secp256k1Keypair{
-		privateKey: privateKey,
+		privateKey: secmem.NewBufferFrom(privateKey),
+		mnemonic:   secmem.NewBuffer(0),
 	}
 }
 
@@ -39,12 +47,37 @@ func NewSecp256k1Keypair(privateKey stdx.Bytes) *Secp256k1Keypair {
 // with each others.
 func NewSecp256k1KeypairWithMetadata(privateKey stdx.Bytes, mnemonic, derivationPath string) *Secp256k1Keypair {
 	return &Secp256k1Keypair{
-		privateKey:     privateKey,
-		mnemonic:       mnemonic,
+		privateKey:     secmem.NewBufferFrom(privateKey),
+		mnemonic:       secmem.NewBufferFrom([]byte(mnemonic)),
 		derivationPath: derivationPath,
 	}
 }
 
+// Wipes the private key and mnemonic from memory. The keypair must not be used
+// after Destroy. It is safe to call Destroy more than once.
+func (p *Secp256k1Keypair) Destroy() {
+	p.privateKey.Destroy()
+	p.mnemonic.Destroy()
+}
+
+// Returns true if the keypair has been destroyed.
+func (p *Secp256k1Keypair) IsDestroyed() bool {
+	return p.privateKey.IsDestroyed()
+}
+
+// Returns the public key of the keypair, the private key and mnemonic are redacted.
+func (p *Secp256k1Keypair) String() string {
+	if p.IsDestroyed() {
+		return "Secp256k1Keypair{destroyed}"
+	}
+	return fmt.Sprintf("Secp256k1Keypair{publicKey: 0x%s, privateKey: %s}", p.PublicKey().HexStr(), secmem.Redacted)
+}
+
+// Returns the same value as String so secrets are also redacted when formatted with %#v.
+func (p *Secp256k1Keypair) GoString() string {
+	return p.String()
+}
+
 // Returns the derivation path linked to this keypair.
 // This struct does not validate if the mnemonic, derivationPath and privateKey having relationship
 // with each others.
@@ -56,28 +89,61 @@ func (p *Secp256k1Keypair) DerivationPath() string {
 // This struct does not validate if the mnemonic, derivationPath and privateKey having relationship
 // with each others.
 func (p *Secp256k1Keypair) Mnemonic() string {
-	return p.mnemonic
+	mnemonic := string(p.mnemonic.Bytes())
+	runtime.KeepAlive(p.mnemonic)
+	return mnemonic
 }
 
-// Returns the private key in Bitcoin format.
+// Returns a copy of the private key in Bitcoin format.
+// The copy is not locked, callers should wipe it with secmem.Wipe after use.
 func (p *Secp256k1Keypair) PrivateKey() stdx.Bytes {
-	return p.privateKey
+	privateKey := make([]byte, p.privateKey.Len())
+	copy(privateKey, p.privateKey.Bytes())
+	runtime.KeepAlive(p.privateKey)
+	return stdx.Bytes(privateKey)
 }
 
 // Returns the compressed public key in Bitcoin format.
 func (p *Secp256k1Keypair) PublicKey() stdx.Bytes {
 	curve := btcutil.Secp256k1()
-	pubkey := compressPublicKey(curve.ScalarBaseMult(p.privateKey))
+	pubkey := compressPublicKey(curve.ScalarBaseMult(p.privateKey.Bytes()))
+	runtime.KeepAlive(p.privateKey)
 	return stdx.Bytes(pubkey)
 }
 
 // Returns the uncompressed public key in Bitcoin format.
 func (p *Secp256k1Keypair) UncompressPublicKey() stdx.Bytes {
 	curve := btcutil.Secp256k1()
-	pubkey := uncompressPublicKey(curve.ScalarBaseMult(p.privateKey))
+	pubkey := uncompressPublicKey(curve.ScalarBaseMult(p.privateKey.Bytes()))
+	runtime.KeepAlive(p.privateKey)
 	return stdx.Bytes(pubkey)
 }
 
+// Returns the point (x, y) of a public key in compressed or uncompressed format.
+// Returns error if the point is not on Secp256k1 curve.
+func ParsePublicKey(publicKey stdx.Bytes) (*big.Int, *big.Int, error) {
+	switch {
+	case len(publicKey) == Secp256k1PointLength+1 && (publicKey[0] == 0x2 || publicKey[0] == 0x3):
+		x := new(big.Int).SetBytes(publicKey[1:])
+		if x.Cmp(btcutil.Secp256k1().Params().P) >= 0 {
+			return nil, nil, errors.New("point is not on curve")
+		}
+		y, err := decompressY(x, uint(publicKey[0]&1))
+		if err != nil {
+			return nil, nil, err
+		}
+		return x, y, nil
+	case len(publicKey) == 2*Secp256k1PointLength+1 && publicKey[0] == 0x4:
+		x := new(big.Int).SetBytes(publicKey[1 : Secp256k1PointLength+1])
+		y := new(big.Int).SetBytes(publicKey[Secp256k1PointLength+1:])
+		if !btcutil.Secp256k1().IsOnCurve(x, y) {
+			return nil, nil, errors.New("point is not on curve")
+		}
+		return x, y, nil
+	}
+	return nil, nil, errors.New("invalid public key format")
+}
+
 // Turns a point with coordinate (x, y) into compressed format: header + x value.
 // Header value will be 0x2 for even y value, 0x3 for odd y value.
 func compressPublicKey(x *big.Int, y *big.Int) []byte {
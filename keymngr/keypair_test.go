@@ -0,0 +1,91 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lukaz17/cryptotool-go/secmem"
+)
+
+func TestSecp256k1Keypair_Redact(t *testing.T) {
+	mnemonic := "repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat rescue"
+	key, _ := DeriveKeyFromMnemonic(mnemonic, "", "m/44'/60'/0'/0/0")
+	keypair := NewSecp256k1KeypairWithMetadata(key.Key, mnemonic, "m/44'/60'/0'/0/0")
+	WipeKey(key)
+	privateKey := keypair.PrivateKey().HexStr()
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"keypair", keypair},
+		{"ethereum_account", NewEthereumAccount(keypair)},
+		{"bitcoin_account", NewBitcoinAccount(keypair, BitcoinMainnet)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, format := range []string{"%v", "%+v", "%#v", "%s", "%x"} {
+				output := fmt.Sprintf(format, tt.value)
+				if strings.Contains(strings.ToLower(output), privateKey) || strings.Contains(output, "rescue") {
+					t.Errorf("secret is leaked with %s: %s", format, output)
+				}
+			}
+		})
+	}
+}
+
+func TestSecp256k1Keypair_Destroy(t *testing.T) {
+	tests := []struct {
+		name       string
+		privateKey string
+	}{
+		{"ethereum_account", "6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKey, _ := hex.DecodeString(tt.privateKey)
+			account := NewEthereumAccount(NewSecp256k1Keypair(privateKey))
+			if account.PrivateKey().HexStr() != tt.privateKey {
+				t.Fatalf("invalid private key. expected %s actual %s", tt.privateKey, account.PrivateKey().HexStr())
+			}
+			account.Destroy()
+			// A destroyed key must never be mistaken for a valid one, e.g. the point at infinity.
+			for name, use := range map[string]func(){
+				"PrivateKey": func() { account.PrivateKey() },
+				"PublicKey":  func() { account.PublicKey() },
+				"AddressStr": func() { account.AddressStr() },
+			} {
+				func() {
+					defer func() {
+						if recover() != secmem.ErrDestroyed {
+							t.Errorf("%s must panic after destroy", name)
+						}
+					}()
+					use()
+				}()
+			}
+			if account.String() != "EthereumAccount{destroyed}" {
+				t.Errorf("invalid string. actual %s", account.String())
+			}
+			account.Destroy()
+		})
+	}
+}
+
+func TestWipeKey(t *testing.T) {
+	key, _ := DeriveKeyFromMnemonic("repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat rescue", "", "m/44'/60'/0'/0/0")
+	WipeKey(key)
+	for _, b := range append(key.Key, key.ChainCode...) {
+		if b != 0 {
+			t.Fatalf("key is not wiped")
+		}
+	}
+	WipeKey(nil)
+}
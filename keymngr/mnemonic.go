@@ -7,6 +7,7 @@
 package keymngr
 
 import (
+	"github.com/lukaz17/cryptotool-go/secmem"
 	"github.com/tyler-smith/go-bip32"
 	"github.com/tyler-smith/go-bip39"
 )
@@ -27,23 +28,62 @@ func NewMnemonic() (string, []byte, error) {
 
 // Returns the private key derived from mnemonic following BIP-32 specification.
 // To get the master key, use empty string "" or "m" as derivationPath.
+// The seed and intermediate keys are wiped, callers should wipe the returned key with WipeKey after use.
 func DeriveKeyFromMnemonic(mnemonic, password, derivationPath string) (*bip32.Key, error) {
+	if _, err := ParseDerivationPath(derivationPath); err != nil {
+		return nil, err
+	}
 	seed := bip39.NewSeed(mnemonic, password)
-	masterKey, _ := bip32.NewMasterKey(seed)
+	defer secmem.Wipe(seed)
+	return DeriveKeyFromSeed(seed, derivationPath)
+}
+
+// Returns the private key derived from a seed following BIP-32 specification, e.g. the seed of
+// a BIP-39 mnemonic or the master secret recovered from SLIP-39 shares.
+// Intermediate keys are wiped, callers should wipe the returned key with WipeKey after use.
+func DeriveKeyFromSeed(seed []byte, derivationPath string) (*bip32.Key, error) {
 	path, err := ParseDerivationPath(derivationPath)
 	if err != nil {
 		return nil, err
 	}
-	key := masterKey
+	key, err := bip32.NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	child, err := deriveChildKey(key, path)
+	if child != key {
+		WipeKey(key)
+	}
+	return child, err
+}
+
+// Returns the key derived from parent following path. Intermediate keys are wiped, parent is left untouched.
+// parent itself is returned if path is empty.
+func deriveChildKey(parent *bip32.Key, path []DerivationPart) (*bip32.Key, error) {
+	key := parent
 	for _, part := range path {
 		index := part.Index
 		if part.IsHarden {
 			index += bip32.FirstHardenedChild
 		}
-		key, err = key.NewChildKey(index)
+		child, err := key.NewChildKey(index)
+		if key != parent {
+			WipeKey(key)
+		}
 		if err != nil {
 			return nil, err
 		}
+		key = child
 	}
 	return key, nil
 }
+
+// Overwrites the private key and chain code of key with zeros. Note that String of bip32.Key
+// serializes the private key, so the key must never be formatted or logged.
+func WipeKey(key *bip32.Key) {
+	if key == nil {
+		return
+	}
+	secmem.Wipe(key.Key)
+	secmem.Wipe(key.ChainCode)
+}
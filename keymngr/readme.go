@@ -11,5 +11,18 @@ This package also supports import, export key file from popular formats availabl
 
 The following types of accounts are supported:
 Ethereum and EVM based blockchain accounts which use underlying Secp256k1 elliptic curve.
+Bitcoin accounts with legacy (P2PKH), native segwit (P2WPKH) and taproot (P2TR) addresses.
+Solana accounts which use Ed25519 keys derived following SLIP-10, with Solana CLI keypair files.
+Tron accounts which share Secp256k1 keys and address bytes with Ethereum, encoded with Base58Check.
+
+Ethereum accounts can sign legacy (EIP-155), EIP-2930 and EIP-1559 transactions offline.
+
+BIP85 derives child mnemonics, WIF keys, raw entropy and passwords from one master mnemonic
+following BIP-85, so one backup covers many independent wallets.
+SplitSeedXOR and CombineSeedXOR split a mnemonic into mnemonics compatible with Coldcard Seed XOR.
+
+Private keys and mnemonics are kept in locked memory provided by package secmem.
+Call Destroy on keypairs and accounts, and WipeKey on derived keys, once they are no longer needed.
+Formatting keypairs and accounts with String or %v never prints their secrets.
 */
 package keymngr
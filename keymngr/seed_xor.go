@@ -0,0 +1,92 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"crypto/rand"
+	"errors"
+	"strings"
+
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// Returns count parts of a 12, 18 or 24-word mnemonic following Coldcard Seed XOR.
+// Each part is a valid BIP-39 mnemonic of the same length, the entropy of mnemonic is the XOR
+// of entropy of all parts. All parts are required to recover the mnemonic with CombineSeedXOR.
+func SplitSeedXOR(mnemonic string, count int) ([]string, error) {
+	if count < 2 {
+		return nil, errors.New("number of parts must be at least 2")
+	}
+	entropy, err := seedXOREntropy(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	defer secmem.Wipe(entropy)
+	last := append([]byte{}, entropy...)
+	defer secmem.Wipe(last)
+	part := make([]byte, len(entropy))
+	defer secmem.Wipe(part)
+	parts := make([]string, count)
+	for i := 0; i < count-1; i++ {
+		if _, err := rand.Read(part); err != nil {
+			return nil, err
+		}
+		for j := range last {
+			last[j] ^= part[j]
+		}
+		if parts[i], err = bip39.NewMnemonic(part); err != nil {
+			return nil, err
+		}
+	}
+	if parts[count-1], err = bip39.NewMnemonic(last); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+// Returns the mnemonic whose entropy is the XOR of entropy of parts following Coldcard Seed XOR.
+// All parts must be valid BIP-39 mnemonics of the same length.
+func CombineSeedXOR(parts []string) (string, error) {
+	if len(parts) < 2 {
+		return "", errors.New("at least 2 parts are required")
+	}
+	var result []byte
+	defer func() { secmem.Wipe(result) }()
+	for i, part := range parts {
+		entropy, err := seedXOREntropy(part)
+		if err != nil {
+			return "", err
+		}
+		if i == 0 {
+			result = entropy
+			continue
+		}
+		if len(entropy) != len(result) {
+			secmem.Wipe(entropy)
+			return "", errors.New("all parts must have the same number of words")
+		}
+		for j := range result {
+			result[j] ^= entropy[j]
+		}
+		secmem.Wipe(entropy)
+	}
+	return bip39.NewMnemonic(result)
+}
+
+// Returns the entropy of a 12, 18 or 24-word mnemonic after validating its checksum.
+func seedXOREntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) != 12 && len(words) != 18 && len(words) != 24 {
+		return nil, errors.New("mnemonic must have 12, 18 or 24 words")
+	}
+	entropy, err := bip39.EntropyFromMnemonic(strings.Join(words, " "))
+	if err != nil {
+		return nil, errors.New("invalid mnemonic")
+	}
+	return entropy, nil
+}
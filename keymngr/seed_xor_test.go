@@ -0,0 +1,86 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+func TestCombineSeedXOR(t *testing.T) {
+	tests := []struct {
+		name     string
+		parts    []string
+		mnemonic string
+	}{
+		{"coldcard_24_words", []string{
+			"romance wink lottery autumn shop bring dawn tongue range crater truth ability miss spice fitness easy legal release recall obey exchange recycle dragon room",
+			"lion misery divide hurry latin fluid camp advance illegal lab pyramid unaware eager fringe sick camera series noodle toy crowd jeans select depth lounge",
+			"vault nominee cradle silk own frown throw leg cactus recall talent worry gadget surface shy planet purpose coffee drip few seven term squeeze educate",
+		}, "silent toe meat possible chair blossom wait occur this worth option bag nurse find fish scene bench asthma bike wage world quit primary indoor"},
+		{"mismatched_length", []string{
+			"repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat rescue",
+			"silent toe meat possible chair blossom wait occur this worth option bag nurse find fish scene bench asthma bike wage world quit primary indoor",
+		}, ""},
+		{"invalid_checksum", []string{
+			"repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat",
+			"repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat rescue",
+		}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mnemonic, err := CombineSeedXOR(tt.parts)
+			if tt.mnemonic == "" {
+				if err == nil {
+					t.Errorf("expected error. actual %s", mnemonic)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if mnemonic != tt.mnemonic {
+				t.Errorf("invalid mnemonic. expected %s actual %s", tt.mnemonic, mnemonic)
+			}
+		})
+	}
+}
+
+func TestSplitSeedXOR(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		count    int
+	}{
+		{"12_words", "repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat rescue", 2},
+		{"24_words", "silent toe meat possible chair blossom wait occur this worth option bag nurse find fish scene bench asthma bike wage world quit primary indoor", 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts, err := SplitSeedXOR(tt.mnemonic, tt.count)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if len(parts) != tt.count {
+				t.Fatalf("invalid number of parts. expected %d actual %d", tt.count, len(parts))
+			}
+			for _, part := range parts {
+				if !bip39.IsMnemonicValid(part) {
+					t.Errorf("invalid part %s", part)
+				}
+			}
+			mnemonic, err := CombineSeedXOR(parts)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if mnemonic != tt.mnemonic {
+				t.Errorf("invalid mnemonic. expected %s actual %s", tt.mnemonic, mnemonic)
+			}
+		})
+	}
+}
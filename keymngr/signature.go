@@ -0,0 +1,204 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"runtime"
+
+	btcutil "github.com/FactomProject/btcutilecc"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	Secp256k1SignatureLength = 65
+)
+
+// Returns a recoverable signature of a 32-byte hash in [R || S || V] format where V is
+// the recovery id 0 or 1. The nonce is generated deterministically following RFC-6979
+// and S is normalized to the lower half of the curve order.
+func (p *Secp256k1Keypair) Sign(hash stdx.Bytes) (stdx.Bytes, error) {
+	if len(hash) != 32 {
+		return nil, errors.New("hash must be 32 bytes")
+	}
+	curve := btcutil.Secp256k1()
+	n := curve.Params().N
+	d := new(big.Int).SetBytes(p.privateKey.Bytes())
+	if d.Sign() == 0 || d.Cmp(n) >= 0 {
+		return nil, errors.New("invalid private key")
+	}
+	e := new(big.Int).SetBytes(hash)
+	nonces := newRfc6979Generator(p.privateKey.Bytes(), hash)
+	runtime.KeepAlive(p.privateKey)
+	for {
+		k := nonces.next()
+		rx, ry := curve.ScalarBaseMult(padBytes(k.Bytes(), Secp256k1PointLength))
+		r := new(big.Int).Mod(rx, n)
+		if r.Sign() == 0 {
+			continue
+		}
+		kInv := new(big.Int).ModInverse(k, n)
+		s := new(big.Int).Mul(r, d)
+		s.Add(s, e)
+		s.Mul(s, kInv)
+		s.Mod(s, n)
+		if s.Sign() == 0 {
+			continue
+		}
+		recoveryID := byte(ry.Bit(0))
+		if rx.Cmp(n) >= 0 {
+			recoveryID |= 2
+		}
+		halfN := new(big.Int).Rsh(n, 1)
+		if s.Cmp(halfN) > 0 {
+			s.Sub(n, s)
+			recoveryID ^= 1
+		}
+		signature := make([]byte, 0, Secp256k1SignatureLength)
+		signature = append(signature, padBytes(r.Bytes(), 32)...)
+		signature = append(signature, padBytes(s.Bytes(), 32)...)
+		signature = append(signature, recoveryID)
+		return stdx.Bytes(signature), nil
+	}
+}
+
+// Returns the uncompressed public key which produced a [R || S || V] signature of a 32-byte hash.
+func RecoverPublicKey(hash, signature stdx.Bytes) (stdx.Bytes, error) {
+	if len(hash) != 32 {
+		return nil, errors.New("hash must be 32 bytes")
+	}
+	if len(signature) != Secp256k1SignatureLength {
+		return nil, errors.New("signature must be 65 bytes")
+	}
+	curve := btcutil.Secp256k1()
+	params := curve.Params()
+	n := params.N
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:64])
+	recoveryID := signature[64]
+	if recoveryID > 3 {
+		return nil, errors.New("invalid recovery id")
+	}
+	if r.Sign() == 0 || r.Cmp(n) >= 0 || s.Sign() == 0 || s.Cmp(n) >= 0 {
+		return nil, errors.New("invalid signature values")
+	}
+	rx := new(big.Int).Set(r)
+	if recoveryID&2 != 0 {
+		rx.Add(rx, n)
+		if rx.Cmp(params.P) >= 0 {
+			return nil, errors.New("invalid signature values")
+		}
+	}
+	ry, err := decompressY(rx, uint(recoveryID&1))
+	if err != nil {
+		return nil, err
+	}
+	// Q = r^-1 * (s*R - e*G)
+	rInv := new(big.Int).ModInverse(r, n)
+	e := new(big.Int).SetBytes(hash)
+	eNeg := new(big.Int).Sub(n, new(big.Int).Mod(e, n))
+	eNeg.Mod(eNeg, n)
+	sRx, sRy := curve.ScalarMult(rx, ry, padBytes(s.Bytes(), 32))
+	eGx, eGy := curve.ScalarBaseMult(padBytes(eNeg.Bytes(), 32))
+	var sumX, sumY *big.Int
+	if eNeg.Sign() == 0 {
+		sumX, sumY = sRx, sRy
+	} else {
+		sumX, sumY = curve.Add(sRx, sRy, eGx, eGy)
+	}
+	qx, qy := curve.ScalarMult(sumX, sumY, padBytes(rInv.Bytes(), 32))
+	if qx.Sign() == 0 && qy.Sign() == 0 {
+		return nil, errors.New("invalid signature")
+	}
+	return stdx.Bytes(uncompressPublicKey(qx, qy)), nil
+}
+
+// Returns the y coordinate on Secp256k1 for x with the expected parity.
+func decompressY(x *big.Int, parity uint) (*big.Int, error) {
+	p := btcutil.Secp256k1().Params().P
+	// y^2 = x^3 + 7
+	y2 := new(big.Int).Exp(x, big.NewInt(3), p)
+	y2.Add(y2, big.NewInt(7))
+	y2.Mod(y2, p)
+	// p = 3 mod 4 so the square root is y2^((p+1)/4)
+	exp := new(big.Int).Add(p, big.NewInt(1))
+	exp.Rsh(exp, 2)
+	y := new(big.Int).Exp(y2, exp, p)
+	if new(big.Int).Exp(y, big.NewInt(2), p).Cmp(y2) != 0 {
+		return nil, errors.New("point is not on curve")
+	}
+	if y.Bit(0) != parity {
+		y.Sub(p, y)
+	}
+	return y, nil
+}
+
+// Left-pad data with zero bytes to length.
+func padBytes(data []byte, length int) []byte {
+	if len(data) >= length {
+		return data
+	}
+	result := make([]byte, length)
+	copy(result[length-len(data):], data)
+	return result
+}
+
+// rfc6979Generator generates deterministic nonces following RFC-6979 section 3.2
+// using HMAC-SHA256.
+type rfc6979Generator struct {
+	k []byte
+	v []byte
+	n *big.Int
+}
+
+func newRfc6979Generator(privateKey, hash []byte) *rfc6979Generator {
+	n := btcutil.Secp256k1().Params().N
+	x := padBytes(privateKey, 32)
+	h := new(big.Int).SetBytes(hash)
+	h.Mod(h, n)
+	h1 := padBytes(h.Bytes(), 32)
+	g := &rfc6979Generator{
+		k: make([]byte, 32),
+		v: make([]byte, 32),
+		n: n,
+	}
+	for i := range g.v {
+		g.v[i] = 0x01
+	}
+	g.k = g.mac(g.v, []byte{0x00}, x, h1)
+	g.v = g.mac(g.v)
+	g.k = g.mac(g.v, []byte{0x01}, x, h1)
+	g.v = g.mac(g.v)
+	return g
+}
+
+// Returns the next candidate nonce in range [1, n-1].
+func (g *rfc6979Generator) next() *big.Int {
+	for {
+		g.v = g.mac(g.v)
+		k := new(big.Int).SetBytes(g.v)
+		if k.Sign() > 0 && k.Cmp(g.n) < 0 {
+			// Prepare state for the next call in case this nonce is rejected.
+			g.k = g.mac(g.v, []byte{0x00})
+			g.v = g.mac(g.v)
+			return k
+		}
+		g.k = g.mac(g.v, []byte{0x00})
+		g.v = g.mac(g.v)
+	}
+}
+
+func (g *rfc6979Generator) mac(parts ...[]byte) []byte {
+	h := hmac.New(sha256.New, g.k)
+	for _, part := range parts {
+		h.Write(part)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+
+	"github.com/lukaz17/cryptotool-go/base58"
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+// A SolanaAccount derives the address and keypair file of Solana based on Ed25519Keypair.
+type SolanaAccount struct {
+	keypair *Ed25519Keypair
+}
+
+// Returns a SolanaAccount from an Ed25519Keypair.
+func NewSolanaAccount(keypair *Ed25519Keypair) *SolanaAccount {
+	return &SolanaAccount{
+		keypair: keypair,
+	}
+}
+
+// Returns the SolanaAccount of account index derived from mnemonic at the path used by
+// Phantom and Solflare wallets, see SolanaDerivationPath.
+func NewSolanaAccountFromMnemonic(mnemonic, password string, index uint32) (*SolanaAccount, error) {
+	derivationPath := SolanaDerivationPath(index)
+	privateKey, err := DeriveEd25519KeyFromMnemonic(mnemonic, password, derivationPath)
+	if err != nil {
+		return nil, err
+	}
+	defer secmem.Wipe(privateKey)
+	keypair, err := NewEd25519Keypair(privateKey, derivationPath)
+	if err != nil {
+		return nil, err
+	}
+	return NewSolanaAccount(keypair), nil
+}
+
+// Returns the derivation path m/44'/501'/index'/0' of account index used by Phantom and Solflare wallets.
+func SolanaDerivationPath(index uint32) string {
+	return fmt.Sprintf("m/44'/501'/%d'/0'", index)
+}
+
+// Returns the SolanaAccount from a keypair file of Solana CLI, which is a JSON array of 64 bytes:
+// the private key seed followed by the public key.
+func ParseSolanaKeypairJSON(data []byte) (*SolanaAccount, error) {
+	var values []int
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, errors.New("keypair file must be a JSON array of bytes")
+	}
+	if len(values) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("keypair file must contain %d bytes", ed25519.PrivateKeySize)
+	}
+	privateKey := make([]byte, len(values))
+	defer secmem.Wipe(privateKey)
+	for i, value := range values {
+		if value < 0 || value > 255 {
+			return nil, errors.New("keypair file must be a JSON array of bytes")
+		}
+		privateKey[i] = byte(value)
+		values[i] = 0
+	}
+	keypair, err := NewEd25519Keypair(privateKey[:ed25519.SeedSize], "")
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(keypair.PublicKey(), privateKey[ed25519.SeedSize:]) {
+		keypair.Destroy()
+		return nil, errors.New("public key does not match private key")
+	}
+	return NewSolanaAccount(keypair), nil
+}
+
+// Returns the base58 address, which is the encoded public key.
+func (a *SolanaAccount) Address() string {
+	return base58.Encode(a.keypair.PublicKey())
+}
+
+// Returns the 32-byte public key.
+func (a *SolanaAccount) PublicKey() stdx.Bytes {
+	return a.keypair.PublicKey()
+}
+
+// Returns the derivation path linked to underlying keypair.
+func (a *SolanaAccount) DerivationPath() string {
+	return a.keypair.derivationPath
+}
+
+// Returns the keypair file of Solana CLI, a JSON array of the private key seed followed by the public key.
+// The file contains the private key in plaintext, callers should wipe it with secmem.Wipe after use.
+func (a *SolanaAccount) KeypairJSON() []byte {
+	privateKey := ed25519.NewKeyFromSeed(a.keypair.privateKey.Bytes())
+	runtime.KeepAlive(a.keypair.privateKey)
+	defer secmem.Wipe(privateKey)
+	data := make([]byte, 0, 4*len(privateKey)+2)
+	data = append(data, '[')
+	for i, b := range privateKey {
+		if i > 0 {
+			data = append(data, ',')
+		}
+		data = strconv.AppendUint(data, uint64(b), 10)
+	}
+	return append(data, ']')
+}
+
+// Returns the Ed25519 signature of a serialized transaction message.
+func (a *SolanaAccount) Sign(message []byte) stdx.Bytes {
+	return a.keypair.Sign(message)
+}
+
+// Wipes the secrets of underlying keypair from memory. The account must not be used
+// after Destroy.
+func (a *SolanaAccount) Destroy() {
+	a.keypair.Destroy()
+}
+
+// Returns the address of the account, the private key is redacted.
+func (a *SolanaAccount) String() string {
+	if a.keypair.IsDestroyed() {
+		return "SolanaAccount{destroyed}"
+	}
+	return fmt.Sprintf("SolanaAccount{address: %s, privateKey: %s}", a.Address(), secmem.Redacted)
+}
+
+// Returns the same value as String so secrets are also redacted when formatted with %#v.
+func (a *SolanaAccount) GoString() string {
+	return a.String()
+}
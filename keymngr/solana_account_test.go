@@ -0,0 +1,109 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestDeriveEd25519KeyFromSeed(t *testing.T) {
+	tests := []struct {
+		name           string
+		derivationPath string
+		privateKey     string
+		publicKey      string
+	}{
+		{"slip10_master", "m", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed"},
+		{"slip10_0h", "m/0'", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c"},
+		{"non_hardened", "m/0'/1", "", ""},
+	}
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKey, err := DeriveEd25519KeyFromSeed(seed, tt.derivationPath)
+			if tt.privateKey == "" {
+				if err == nil {
+					t.Errorf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if privateKey.HexStr() != tt.privateKey {
+				t.Errorf("invalid private key. expected %s actual %s", tt.privateKey, privateKey.HexStr())
+			}
+			keypair, _ := NewEd25519Keypair(privateKey, tt.derivationPath)
+			if keypair.PublicKey().HexStr() != tt.publicKey {
+				t.Errorf("invalid public key. expected %s actual %s", tt.publicKey, keypair.PublicKey().HexStr())
+			}
+		})
+	}
+}
+
+func TestSolanaAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		index    uint32
+		address  string
+	}{
+		{"phantom_0", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", 0, "HAgk14JpMQLgt6rVgv7cBQFJWFto5Dqxi472uT3DKpqk"},
+		{"phantom_1", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", 1, "Hh8QwFUA6MtVu1qAoq12ucvFHNwCcVTV7hpWjeY1Hztb"},
+		{"phantom_2", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", 2, "7WktogJEd2wQ9eH2oWusmcoFTgeYi6rS632UviTBJ2jm"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := NewSolanaAccountFromMnemonic(tt.mnemonic, "", tt.index)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			defer account.Destroy()
+			if account.Address() != tt.address {
+				t.Errorf("invalid address. expected %s actual %s", tt.address, account.Address())
+			}
+			imported, err := ParseSolanaKeypairJSON(account.KeypairJSON())
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if imported.Address() != tt.address {
+				t.Errorf("invalid imported address. expected %s actual %s", tt.address, imported.Address())
+			}
+			message := []byte("hello")
+			if !ed25519.Verify(ed25519.PublicKey(account.PublicKey()), message, imported.Sign(message)) {
+				t.Errorf("invalid signature")
+			}
+			if !strings.Contains(account.String(), tt.address) || strings.Contains(account.String(), account.keypair.PrivateKey().HexStr()) {
+				t.Errorf("invalid string %s", account.String())
+			}
+		})
+	}
+}
+
+func TestParseSolanaKeypairJSON_Invalid(t *testing.T) {
+	account, _ := NewSolanaAccountFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "", 0)
+	valid := string(account.KeypairJSON())
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"not_array", `{"key": 1}`},
+		{"short", "[1,2,3]"},
+		{"out_of_range", strings.Replace(valid, "[55,", "[256,", 1)},
+		{"mismatched_public_key", strings.Replace(valid, ",247]", ",248]", 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseSolanaKeypairJSON([]byte(tt.data)); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
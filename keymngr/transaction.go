@@ -0,0 +1,695 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lukaz17/cryptotool-go/hasher"
+	"github.com/lukaz17/cryptotool-go/rlp"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	LegacyTxType     = 0x00
+	AccessListTxType = 0x01
+	DynamicFeeTxType = 0x02
+)
+
+// An AccessTuple is an entry of EIP-2930 access list.
+type AccessTuple struct {
+	Address     stdx.Bytes
+	StorageKeys []stdx.Bytes
+}
+
+// An EthereumTransaction contains fields of a legacy (EIP-155), EIP-2930 or EIP-1559 transaction.
+// GasPrice is used by type 0 and type 1 transactions, GasTipCap and GasFeeCap are used by
+// type 2 transactions. To is nil for contract creation. V, R and S are nil until the transaction is signed.
+type EthereumTransaction struct {
+	Type       uint8
+	ChainID    *big.Int
+	Nonce      uint64
+	GasPrice   *big.Int
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         stdx.Bytes
+	Value      *big.Int
+	Data       stdx.Bytes
+	AccessList []AccessTuple
+	V          *big.Int
+	R          *big.Int
+	S          *big.Int
+}
+
+// Returns an EthereumTransaction decoded from raw RLP bytes.
+// Both unsigned payloads and signed transactions are accepted.
+// Typed transactions must be prefixed by their type byte following EIP-2718 specification.
+func DecodeEthereumTransaction(raw stdx.Bytes) (*EthereumTransaction, error) {
+	if len(raw) == 0 {
+		return nil, errors.New("empty transaction data")
+	}
+	tx := &EthereumTransaction{}
+	payload := raw.ByteArr()
+	if raw[0] < 0x7f {
+		tx.Type = raw[0]
+		payload = raw[1:]
+		if tx.Type != AccessListTxType && tx.Type != DynamicFeeTxType {
+			return nil, fmt.Errorf("unsupported transaction type %d", tx.Type)
+		}
+	}
+	decoded, err := rlp.Decode(payload)
+	if err != nil {
+		return nil, err
+	}
+	fields, ok := decoded.([]interface{})
+	if !ok {
+		return nil, errors.New("transaction must be a rlp list")
+	}
+	switch tx.Type {
+	case LegacyTxType:
+		err = tx.decodeLegacyFields(fields)
+	case AccessListTxType:
+		err = tx.decodeTypedFields(fields, 8)
+	case DynamicFeeTxType:
+		err = tx.decodeTypedFields(fields, 9)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Returns an EthereumTransaction decoded from raw RLP in 0x hex string.
+func DecodeEthereumTransactionHex(rawHex string) (*EthereumTransaction, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(rawHex), "0x"))
+	if err != nil {
+		return nil, err
+	}
+	return DecodeEthereumTransaction(raw)
+}
+
+// Returns an EthereumTransaction parsed from JSON following field names of Ethereum JSON-RPC.
+// Quantities can be 0x hex strings, decimal strings or JSON numbers.
+// If type is absent, it is inferred from presence of maxFeePerGas and accessList fields.
+func ParseEthereumTransactionJSON(data []byte) (*EthereumTransaction, error) {
+	var jtx jsonTransaction
+	if err := json.Unmarshal(data, &jtx); err != nil {
+		return nil, err
+	}
+	tx := &EthereumTransaction{}
+	switch {
+	case jtx.Type != nil:
+		txType := jtx.Type.Int()
+		if !txType.IsUint64() || txType.Uint64() > DynamicFeeTxType {
+			return nil, fmt.Errorf("unsupported transaction type %s", txType)
+		}
+		tx.Type = uint8(txType.Uint64())
+	case jtx.MaxFeePerGas != nil:
+		tx.Type = DynamicFeeTxType
+	case jtx.AccessList != nil:
+		tx.Type = AccessListTxType
+	default:
+		tx.Type = LegacyTxType
+	}
+	tx.ChainID = jtx.ChainID.Int()
+	var err error
+	if tx.Nonce, err = jtx.Nonce.Uint64("nonce"); err != nil {
+		return nil, err
+	}
+	tx.GasPrice = jtx.GasPrice.Int()
+	tx.GasTipCap = jtx.MaxPriorityFeePerGas.Int()
+	tx.GasFeeCap = jtx.MaxFeePerGas.Int()
+	if jtx.Gas != nil {
+		tx.Gas, err = jtx.Gas.Uint64("gas")
+	} else {
+		tx.Gas, err = jtx.GasLimit.Uint64("gasLimit")
+	}
+	if err != nil {
+		return nil, err
+	}
+	tx.Value = jtx.Value.Int()
+	if tx.Value == nil {
+		tx.Value = new(big.Int)
+	}
+	if jtx.To != "" {
+		tx.To, err = decodeHexField("to", jtx.To, 20)
+		if err != nil {
+			return nil, err
+		}
+	}
+	input := jtx.Input
+	if input == "" {
+		input = jtx.Data
+	}
+	tx.Data, err = decodeHexField("input", input, -1)
+	if err != nil {
+		return nil, err
+	}
+	for _, jtuple := range jtx.AccessList {
+		tuple := AccessTuple{}
+		tuple.Address, err = decodeHexField("accessList.address", jtuple.Address, 20)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range jtuple.StorageKeys {
+			storageKey, err := decodeHexField("accessList.storageKeys", key, 32)
+			if err != nil {
+				return nil, err
+			}
+			tuple.StorageKeys = append(tuple.StorageKeys, storageKey)
+		}
+		tx.AccessList = append(tx.AccessList, tuple)
+	}
+	if err := tx.validate(); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Returns the hash of the transaction. For unsigned transaction, this is the signing hash.
+func (tx *EthereumTransaction) Hash() (stdx.Bytes, error) {
+	if !tx.IsSigned() {
+		return tx.SigningHash()
+	}
+	raw, err := tx.Raw()
+	if err != nil {
+		return nil, err
+	}
+	return hasher.Keccak256(raw), nil
+}
+
+// Returns the hash of the transaction in 0x hex string.
+func (tx *EthereumTransaction) HashStr() (string, error) {
+	hash, err := tx.Hash()
+	if err != nil {
+		return "", err
+	}
+	return stdx.NewHex(hash, true).Value(), nil
+}
+
+// Returns true if V, R and S are all present.
+func (tx *EthereumTransaction) IsSigned() bool {
+	return tx.V != nil && tx.R != nil && tx.S != nil
+}
+
+// Returns the raw transaction bytes following EIP-2718 envelope.
+// Signed transaction will include the signature, otherwise the unsigned payload is returned.
+func (tx *EthereumTransaction) Raw() (stdx.Bytes, error) {
+	if err := tx.validate(); err != nil {
+		return nil, err
+	}
+	fields := tx.payloadFields()
+	if tx.IsSigned() {
+		fields = append(fields, tx.V, tx.R, tx.S)
+	} else if tx.Type == LegacyTxType && tx.ChainID != nil && tx.ChainID.Sign() > 0 {
+		fields = append(fields, tx.ChainID, uint64(0), uint64(0))
+	}
+	return tx.envelope(fields)
+}
+
+// Returns the raw transaction bytes in 0x hex string.
+func (tx *EthereumTransaction) RawStr() (string, error) {
+	raw, err := tx.Raw()
+	if err != nil {
+		return "", err
+	}
+	return stdx.NewHex(raw, true).Value(), nil
+}
+
+// Returns the checksum address of the account which signed the transaction.
+func (tx *EthereumTransaction) Sender() (string, error) {
+	if !tx.IsSigned() {
+		return "", errors.New("transaction is not signed")
+	}
+	hash, err := tx.SigningHash()
+	if err != nil {
+		return "", err
+	}
+	recoveryID := new(big.Int).Set(tx.V)
+	if tx.Type == LegacyTxType {
+		if tx.ChainID != nil && tx.ChainID.Sign() > 0 {
+			recoveryID.Sub(recoveryID, new(big.Int).Mul(tx.ChainID, big.NewInt(2)))
+			recoveryID.Sub(recoveryID, big.NewInt(35))
+		} else {
+			recoveryID.Sub(recoveryID, big.NewInt(27))
+		}
+	}
+	if !recoveryID.IsUint64() || recoveryID.Uint64() > 1 {
+		return "", errors.New("invalid signature v value")
+	}
+	signature := make([]byte, 0, Secp256k1SignatureLength)
+	signature = append(signature, padBytes(tx.R.Bytes(), 32)...)
+	signature = append(signature, padBytes(tx.S.Bytes(), 32)...)
+	signature = append(signature, byte(recoveryID.Uint64()))
+	uPubkey, err := RecoverPublicKey(hash, signature)
+	if err != nil {
+		return "", err
+	}
+	address := hasher.Keccak256(uPubkey[1:])[12:]
+	return CreateChecksumAddress(stdx.NewHex(address, true).Value(), nil)
+}
+
+// Returns the hash which must be signed by the sender following EIP-155, EIP-2930 or EIP-1559
+// depending on transaction type.
+func (tx *EthereumTransaction) SigningHash() (stdx.Bytes, error) {
+	if err := tx.validate(); err != nil {
+		return nil, err
+	}
+	fields := tx.payloadFields()
+	if tx.Type == LegacyTxType && tx.ChainID != nil && tx.ChainID.Sign() > 0 {
+		fields = append(fields, tx.ChainID, uint64(0), uint64(0))
+	}
+	payload, err := tx.envelope(fields)
+	if err != nil {
+		return nil, err
+	}
+	return hasher.Keccak256(payload), nil
+}
+
+// Returns a human readable multi-line description of the transaction.
+func (tx *EthereumTransaction) String() string {
+	var sb strings.Builder
+	typeNames := map[uint8]string{
+		LegacyTxType:     "Legacy",
+		AccessListTxType: "EIP-2930",
+		DynamicFeeTxType: "EIP-1559",
+	}
+	fmt.Fprintf(&sb, "Type:                     %d (%s)\n", tx.Type, typeNames[tx.Type])
+	fmt.Fprintf(&sb, "Chain ID:                 %s\n", formatBigInt(tx.ChainID))
+	fmt.Fprintf(&sb, "Nonce:                    %d\n", tx.Nonce)
+	if tx.Type == DynamicFeeTxType {
+		fmt.Fprintf(&sb, "Max priority fee per gas: %s wei\n", formatBigInt(tx.GasTipCap))
+		fmt.Fprintf(&sb, "Max fee per gas:          %s wei\n", formatBigInt(tx.GasFeeCap))
+	} else {
+		fmt.Fprintf(&sb, "Gas price:                %s wei\n", formatBigInt(tx.GasPrice))
+	}
+	fmt.Fprintf(&sb, "Gas limit:                %d\n", tx.Gas)
+	if tx.To == nil {
+		fmt.Fprintf(&sb, "To:                       (contract creation)\n")
+	} else {
+		to, _ := CreateChecksumAddress(stdx.NewHex(tx.To, true).Value(), nil)
+		fmt.Fprintf(&sb, "To:                       %s\n", to)
+	}
+	fmt.Fprintf(&sb, "Value:                    %s wei\n", formatBigInt(tx.Value))
+	fmt.Fprintf(&sb, "Data:                     %s\n", stdx.NewHex(tx.Data, true).Value())
+	for _, tuple := range tx.AccessList {
+		address, _ := CreateChecksumAddress(stdx.NewHex(tuple.Address, true).Value(), nil)
+		fmt.Fprintf(&sb, "Access list:              %s\n", address)
+		for _, key := range tuple.StorageKeys {
+			fmt.Fprintf(&sb, "                            %s\n", stdx.NewHex(key, true).Value())
+		}
+	}
+	if tx.IsSigned() {
+		sender, err := tx.Sender()
+		if err != nil {
+			sender = "(invalid signature)"
+		}
+		fmt.Fprintf(&sb, "From:                     %s\n", sender)
+	} else {
+		fmt.Fprintf(&sb, "From:                     (unsigned)\n")
+	}
+	return sb.String()
+}
+
+// Returns a signed copy of the transaction using the underlying keypair.
+// The original transaction is not modified.
+func (a *EthereumAccount) SignTransaction(tx *EthereumTransaction) (*EthereumTransaction, error) {
+	if tx.ChainID == nil || tx.ChainID.Sign() <= 0 {
+		return nil, errors.New("chain id is required for replay protection")
+	}
+	signed := *tx
+	signed.V, signed.R, signed.S = nil, nil, nil
+	hash, err := signed.SigningHash()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := a.keypair.Sign(hash)
+	if err != nil {
+		return nil, err
+	}
+	signed.R = new(big.Int).SetBytes(signature[:32])
+	signed.S = new(big.Int).SetBytes(signature[32:64])
+	signed.V = big.NewInt(int64(signature[64]))
+	if signed.Type == LegacyTxType {
+		signed.V.Add(signed.V, new(big.Int).Mul(signed.ChainID, big.NewInt(2)))
+		signed.V.Add(signed.V, big.NewInt(35))
+	}
+	return &signed, nil
+}
+
+func (tx *EthereumTransaction) decodeLegacyFields(fields []interface{}) error {
+	if len(fields) != 6 && len(fields) != 9 {
+		return errors.New("legacy transaction must have 6 or 9 fields")
+	}
+	var err error
+	if tx.Nonce, err = decodeUintField("nonce", fields[0]); err != nil {
+		return err
+	}
+	if tx.GasPrice, err = decodeBigIntField("gasPrice", fields[1]); err != nil {
+		return err
+	}
+	if tx.Gas, err = decodeUintField("gas", fields[2]); err != nil {
+		return err
+	}
+	if tx.To, err = decodeAddressField(fields[3]); err != nil {
+		return err
+	}
+	if tx.Value, err = decodeBigIntField("value", fields[4]); err != nil {
+		return err
+	}
+	if tx.Data, err = decodeBytesField("data", fields[5]); err != nil {
+		return err
+	}
+	if len(fields) == 6 {
+		return nil
+	}
+	v, err := decodeBigIntField("v", fields[6])
+	if err != nil {
+		return err
+	}
+	r, err := decodeBigIntField("r", fields[7])
+	if err != nil {
+		return err
+	}
+	s, err := decodeBigIntField("s", fields[8])
+	if err != nil {
+		return err
+	}
+	if r.Sign() == 0 && s.Sign() == 0 {
+		// Unsigned EIP-155 payload [..., chainId, 0, 0]
+		tx.ChainID = v
+		return nil
+	}
+	tx.V, tx.R, tx.S = v, r, s
+	if v.Cmp(big.NewInt(35)) >= 0 {
+		chainID := new(big.Int).Sub(v, big.NewInt(35))
+		tx.ChainID = chainID.Rsh(chainID, 1)
+	}
+	return nil
+}
+
+func (tx *EthereumTransaction) decodeTypedFields(fields []interface{}, count int) error {
+	if len(fields) != count && len(fields) != count+3 {
+		return fmt.Errorf("type %d transaction must have %d or %d fields", tx.Type, count, count+3)
+	}
+	var err error
+	if tx.ChainID, err = decodeBigIntField("chainId", fields[0]); err != nil {
+		return err
+	}
+	if tx.Nonce, err = decodeUintField("nonce", fields[1]); err != nil {
+		return err
+	}
+	i := 2
+	if tx.Type == DynamicFeeTxType {
+		if tx.GasTipCap, err = decodeBigIntField("maxPriorityFeePerGas", fields[i]); err != nil {
+			return err
+		}
+		if tx.GasFeeCap, err = decodeBigIntField("maxFeePerGas", fields[i+1]); err != nil {
+			return err
+		}
+		i += 2
+	} else {
+		if tx.GasPrice, err = decodeBigIntField("gasPrice", fields[i]); err != nil {
+			return err
+		}
+		i++
+	}
+	if tx.Gas, err = decodeUintField("gas", fields[i]); err != nil {
+		return err
+	}
+	if tx.To, err = decodeAddressField(fields[i+1]); err != nil {
+		return err
+	}
+	if tx.Value, err = decodeBigIntField("value", fields[i+2]); err != nil {
+		return err
+	}
+	if tx.Data, err = decodeBytesField("data", fields[i+3]); err != nil {
+		return err
+	}
+	if tx.AccessList, err = decodeAccessList(fields[i+4]); err != nil {
+		return err
+	}
+	if len(fields) == count {
+		return nil
+	}
+	if tx.V, err = decodeBigIntField("yParity", fields[count]); err != nil {
+		return err
+	}
+	if !tx.V.IsUint64() || tx.V.Uint64() > 1 {
+		return fmt.Errorf("invalid yParity %s, must be 0 or 1", tx.V)
+	}
+	if tx.R, err = decodeBigIntField("r", fields[count+1]); err != nil {
+		return err
+	}
+	if tx.S, err = decodeBigIntField("s", fields[count+2]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Returns the RLP encoded payload prefixed with type byte for typed transactions.
+func (tx *EthereumTransaction) envelope(fields []interface{}) (stdx.Bytes, error) {
+	encoded, err := rlp.Encode(fields)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Type == LegacyTxType {
+		return stdx.Bytes(encoded), nil
+	}
+	return stdx.Bytes(append([]byte{tx.Type}, encoded...)), nil
+}
+
+// Returns the transaction fields without signature in RLP order.
+func (tx *EthereumTransaction) payloadFields() []interface{} {
+	to := []byte{}
+	if tx.To != nil {
+		to = tx.To
+	}
+	data := []byte{}
+	if tx.Data != nil {
+		data = tx.Data
+	}
+	switch tx.Type {
+	case AccessListTxType:
+		return []interface{}{tx.ChainID, tx.Nonce, tx.GasPrice, tx.Gas, to, tx.Value, data, tx.encodeAccessList()}
+	case DynamicFeeTxType:
+		return []interface{}{tx.ChainID, tx.Nonce, tx.GasTipCap, tx.GasFeeCap, tx.Gas, to, tx.Value, data, tx.encodeAccessList()}
+	default:
+		return []interface{}{tx.Nonce, tx.GasPrice, tx.Gas, to, tx.Value, data}
+	}
+}
+
+func (tx *EthereumTransaction) encodeAccessList() []interface{} {
+	list := []interface{}{}
+	for _, tuple := range tx.AccessList {
+		keys := []interface{}{}
+		for _, key := range tuple.StorageKeys {
+			keys = append(keys, key.ByteArr())
+		}
+		list = append(list, []interface{}{tuple.Address.ByteArr(), keys})
+	}
+	return list
+}
+
+func (tx *EthereumTransaction) validate() error {
+	if tx.Type > DynamicFeeTxType {
+		return fmt.Errorf("unsupported transaction type %d", tx.Type)
+	}
+	if tx.To != nil && len(tx.To) != 20 {
+		return errors.New("to address must be 20 bytes")
+	}
+	if tx.Type != LegacyTxType && (tx.ChainID == nil || tx.ChainID.Sign() <= 0) {
+		return errors.New("chain id is required for typed transaction")
+	}
+	if tx.Type == DynamicFeeTxType {
+		if tx.GasTipCap == nil || tx.GasFeeCap == nil {
+			return errors.New("maxPriorityFeePerGas and maxFeePerGas are required")
+		}
+		if tx.GasTipCap.Cmp(tx.GasFeeCap) > 0 {
+			return errors.New("maxPriorityFeePerGas must not exceed maxFeePerGas")
+		}
+	} else if tx.GasPrice == nil {
+		return errors.New("gasPrice is required")
+	}
+	if tx.Type == LegacyTxType && len(tx.AccessList) > 0 {
+		return errors.New("legacy transaction does not support access list")
+	}
+	for _, tuple := range tx.AccessList {
+		if len(tuple.Address) != 20 {
+			return errors.New("access list address must be 20 bytes")
+		}
+		for _, key := range tuple.StorageKeys {
+			if len(key) != 32 {
+				return errors.New("access list storage key must be 32 bytes")
+			}
+		}
+	}
+	return nil
+}
+
+func decodeAccessList(field interface{}) ([]AccessTuple, error) {
+	items, ok := field.([]interface{})
+	if !ok {
+		return nil, errors.New("accessList must be a list")
+	}
+	var result []AccessTuple
+	for _, item := range items {
+		tupleFields, ok := item.([]interface{})
+		if !ok || len(tupleFields) != 2 {
+			return nil, errors.New("access list entry must be a list of address and storage keys")
+		}
+		address, ok := tupleFields[0].([]byte)
+		if !ok || len(address) != 20 {
+			return nil, errors.New("access list address must be 20 bytes")
+		}
+		keys, ok := tupleFields[1].([]interface{})
+		if !ok {
+			return nil, errors.New("access list storage keys must be a list")
+		}
+		tuple := AccessTuple{Address: address}
+		for _, key := range keys {
+			storageKey, ok := key.([]byte)
+			if !ok || len(storageKey) != 32 {
+				return nil, errors.New("access list storage key must be 32 bytes")
+			}
+			tuple.StorageKeys = append(tuple.StorageKeys, storageKey)
+		}
+		result = append(result, tuple)
+	}
+	return result, nil
+}
+
+func decodeAddressField(field interface{}) (stdx.Bytes, error) {
+	address, err := decodeBytesField("to", field)
+	if err != nil {
+		return nil, err
+	}
+	if len(address) == 0 {
+		return nil, nil
+	}
+	if len(address) != 20 {
+		return nil, errors.New("to address must be 20 bytes")
+	}
+	return address, nil
+}
+
+func decodeBigIntField(name string, field interface{}) (*big.Int, error) {
+	data, err := decodeBytesField(name, field)
+	if err != nil {
+		return nil, err
+	}
+	value, err := rlp.DecodeBigInt(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return value, nil
+}
+
+func decodeBytesField(name string, field interface{}) (stdx.Bytes, error) {
+	data, ok := field.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a rlp string", name)
+	}
+	return stdx.Bytes(data), nil
+}
+
+func decodeHexField(name, value string, length int) (stdx.Bytes, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	if length >= 0 && len(data) != length {
+		return nil, fmt.Errorf("%s must be %d bytes", name, length)
+	}
+	return stdx.Bytes(data), nil
+}
+
+func decodeUintField(name string, field interface{}) (uint64, error) {
+	data, err := decodeBytesField(name, field)
+	if err != nil {
+		return 0, err
+	}
+	value, err := rlp.DecodeUint64(data)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return value, nil
+}
+
+func formatBigInt(value *big.Int) string {
+	if value == nil {
+		return "-"
+	}
+	return value.String()
+}
+
+// jsonTransaction mirrors transaction object of Ethereum JSON-RPC.
+type jsonTransaction struct {
+	Type                 *jsonQuantity     `json:"type"`
+	ChainID              *jsonQuantity     `json:"chainId"`
+	Nonce                *jsonQuantity     `json:"nonce"`
+	GasPrice             *jsonQuantity     `json:"gasPrice"`
+	MaxPriorityFeePerGas *jsonQuantity     `json:"maxPriorityFeePerGas"`
+	MaxFeePerGas         *jsonQuantity     `json:"maxFeePerGas"`
+	Gas                  *jsonQuantity     `json:"gas"`
+	GasLimit             *jsonQuantity     `json:"gasLimit"`
+	To                   string            `json:"to"`
+	Value                *jsonQuantity     `json:"value"`
+	Input                string            `json:"input"`
+	Data                 string            `json:"data"`
+	AccessList           []jsonAccessTuple `json:"accessList"`
+}
+
+type jsonAccessTuple struct {
+	Address     string   `json:"address"`
+	StorageKeys []string `json:"storageKeys"`
+}
+
+// jsonQuantity accepts 0x hex string, decimal string or JSON number.
+type jsonQuantity big.Int
+
+func (q *jsonQuantity) Int() *big.Int {
+	if q == nil {
+		return nil
+	}
+	return new(big.Int).Set((*big.Int)(q))
+}
+
+// Returns the quantity as uint64, an error mentioning name if it does not fit.
+func (q *jsonQuantity) Uint64(name string) (uint64, error) {
+	if q == nil {
+		return 0, nil
+	}
+	if !(*big.Int)(q).IsUint64() {
+		return 0, fmt.Errorf("%s %s does not fit in uint64", name, (*big.Int)(q))
+	}
+	return (*big.Int)(q).Uint64(), nil
+}
+
+func (q *jsonQuantity) UnmarshalJSON(data []byte) error {
+	str := strings.Trim(string(data), `"`)
+	value := new(big.Int)
+	var ok bool
+	if str == "0x" {
+		ok = true
+	} else if strings.HasPrefix(str, "0x") || strings.HasPrefix(str, "0X") {
+		value, ok = value.SetString(str[2:], 16)
+	} else {
+		value, ok = value.SetString(str, 10)
+	}
+	if !ok || value.Sign() < 0 {
+		return fmt.Errorf("invalid quantity %s", string(data))
+	}
+	*q = jsonQuantity(*value)
+	return nil
+}
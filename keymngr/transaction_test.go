@@ -0,0 +1,156 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestEthereumAccount_SignTransaction(t *testing.T) {
+	// Test cases are generated from go-ethereum v1.14.12
+	tests := []struct {
+		name       string
+		privateKey string
+		txJSON     string
+		raw        string
+		hash       string
+	}{
+		{"eip155_reference", "4646464646464646464646464646464646464646464646464646464646464646",
+			`{"chainId":"0x1","nonce":"0x9","gasPrice":"20000000000","gas":21000,"to":"0x3535353535353535353535353535353535353535","value":"1000000000000000000"}`,
+			"0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83",
+			"0x33469b22e9f636356c4160a87eb19df52b7412e8eac32a4a55ffe88ea8350788"},
+		{"legacy", "6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355",
+			`{"type":"0x0","chainId":"0x1","nonce":"0x9","gasPrice":"0x4a817c800","gas":"0x5208","to":"0x3535353535353535353535353535353535353535","value":"0xde0b6b3a7640000","input":"0x"}`,
+			"0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a02275e401b53b87d7462f3c7c83cc68291951295ff394d273df249fce6c7d66e2a006119b1c3e8b1e661b0792c54be02be39666b493a3c119c1db08d71401bc1069",
+			"0x4f8448977ce4a7b8403f46aba2477b977bb0be0aaeec3ca69f8de70b4e41af79"},
+		{"access_list", "6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355",
+			`{"type":"0x1","chainId":"0x1","nonce":"0x1","gasPrice":"30000000000","gas":"50000","to":"0x3535353535353535353535353535353535353535","value":"12345","data":"0xdeadbeef",
+			"accessList":[{"address":"0x3535353535353535353535353535353535353535","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000001","0x0000000000000000000000000000000000000000000000000000000000000002"]}]}`,
+			"0x01f8c801018506fc23ac0082c35094353535353535353535353535353535353535353582303984deadbeeff85bf859943535353535353535353535353535353535353535f842a00000000000000000000000000000000000000000000000000000000000000001a0000000000000000000000000000000000000000000000000000000000000000280a00153e497440394f72a5b6513ac28dc2de75a5dcb0f3e9f123573cd1b9d555e0ba01712fdf44cc4cc3d97de9754af0a45291217a03f6f60ce1a4f8ca9aed33dcc0e",
+			"0xd11892f0b654f88d3ab0a8d81b299e6bb85fdd320b6cde0b2e778d1472a6f762"},
+		{"dynamic_fee", "6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355",
+			`{"chainId":1,"nonce":7,"maxPriorityFeePerGas":"2000000000","maxFeePerGas":"100000000000","gas":21000,"to":"0x3535353535353535353535353535353535353535","value":"500000000000000000"}`,
+			"0x02f8730107847735940085174876e8008252089435353535353535353535353535353535353535358806f05b59d3b2000080c001a05e8670112af1dc69404c7b51ea9adf61e3e76e2bc61e48fb7066727d88185912a05be4628ab497516fda0086cb6c4581b41861300421bdec964e6c4a8798659536",
+			"0x2426fa1b20810716659fda3f1077610baba293e318423bba719e8df22b5e4977"},
+		{"contract_creation", "6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355",
+			`{"type":"0x2","chainId":"0xaa36a7","nonce":"0x0","maxPriorityFeePerGas":"0x1","maxFeePerGas":"0x2","gas":"0x186a0","input":"0x6080604052"}`,
+			"0x02f85783aa36a7800102830186a08080856080604052c001a0a85665cf8b1a9de607aed9bcecdd1dc7fce921f6ab967693cd8c4fe52ed8a2aba003c32e2f49e2d070f460a0f57d25e080d0643a49d83b36975ad8c908aade5136",
+			"0x934ae157b231d6a194ad40430abdb7411f8cf00d4ecaf5eed71825d49d099c76"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKey, _ := hex.DecodeString(tt.privateKey)
+			account := NewEthereumAccount(NewSecp256k1Keypair(privateKey))
+			tx, err := ParseEthereumTransactionJSON([]byte(tt.txJSON))
+			if err != nil {
+				t.Fatalf("cannot parse transaction: %v", err)
+			}
+			signedTx, err := account.SignTransaction(tx)
+			if err != nil {
+				t.Fatalf("cannot sign transaction: %v", err)
+			}
+			raw, _ := signedTx.RawStr()
+			if raw != tt.raw {
+				t.Errorf("invalid raw transaction. expected %s actual %s", tt.raw, raw)
+			}
+			hash, _ := signedTx.HashStr()
+			if hash != tt.hash {
+				t.Errorf("invalid transaction hash. expected %s actual %s", tt.hash, hash)
+			}
+			sender, err := signedTx.Sender()
+			if err != nil || sender != account.AddressStr() {
+				t.Errorf("invalid sender. expected %s actual %s", account.AddressStr(), sender)
+			}
+			if tx.IsSigned() {
+				t.Errorf("original transaction must not be modified")
+			}
+		})
+	}
+}
+
+func TestDecodeEthereumTransaction(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		txType uint8
+		sender string
+	}{
+		{"signed_legacy", "0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83",
+			LegacyTxType, "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F"},
+		{"signed_dynamic_fee", "0x02f8730107847735940085174876e8008252089435353535353535353535353535353535353535358806f05b59d3b2000080c001a05e8670112af1dc69404c7b51ea9adf61e3e76e2bc61e48fb7066727d88185912a05be4628ab497516fda0086cb6c4581b41861300421bdec964e6c4a8798659536",
+			DynamicFeeTxType, "0x114A781017506df34B3Ed4C0E6B438889a6Eb3F7"},
+		{"unsigned_legacy", "0xec098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000080018080",
+			LegacyTxType, ""},
+		{"unsigned_access_list", "0x01e30101850430e234008252089435353535353535353535353535353535353535358080c0",
+			AccessListTxType, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := DecodeEthereumTransactionHex(tt.raw)
+			if err != nil {
+				t.Fatalf("cannot decode transaction: %v", err)
+			}
+			if tx.Type != tt.txType {
+				t.Errorf("invalid transaction type. expected %d actual %d", tt.txType, tx.Type)
+			}
+			if tx.ChainID == nil || tx.ChainID.Uint64() != 1 {
+				t.Errorf("invalid chain id. expected 1 actual %v", tx.ChainID)
+			}
+			raw, _ := tx.RawStr()
+			if raw != tt.raw {
+				t.Errorf("invalid re-encoded transaction. expected %s actual %s", tt.raw, raw)
+			}
+			if tt.sender == "" {
+				if tx.IsSigned() {
+					t.Errorf("transaction must be unsigned")
+				}
+				return
+			}
+			sender, _ := tx.Sender()
+			if sender != tt.sender {
+				t.Errorf("invalid sender. expected %s actual %s", tt.sender, sender)
+			}
+		})
+	}
+}
+
+func TestParseEthereumTransactionJSON_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		txJSON string
+	}{
+		{"nonce_overflow", `{"chainId":1,"nonce":"18446744073709551621","gasPrice":1,"gas":21000,"to":"0x3535353535353535353535353535353535353535"}`},
+		{"gas_overflow", `{"chainId":1,"nonce":0,"gasPrice":1,"gas":"0x10000000000000005","to":"0x3535353535353535353535353535353535353535"}`},
+		{"gas_limit_overflow", `{"chainId":1,"nonce":0,"gasPrice":1,"gasLimit":18446744073709551621,"to":"0x3535353535353535353535353535353535353535"}`},
+		{"unsupported_type", `{"type":"0x5","chainId":1,"nonce":0,"gasPrice":1,"gas":21000}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseEthereumTransactionJSON([]byte(tt.txJSON)); err == nil {
+				t.Errorf("transaction must be rejected")
+			}
+		})
+	}
+}
+
+func TestDecodeEthereumTransaction_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{"y_parity_2", "0x02f8730107847735940085174876e8008252089435353535353535353535353535353535353535358806f05b59d3b2000080c002a05e8670112af1dc69404c7b51ea9adf61e3e76e2bc61e48fb7066727d88185912a05be4628ab497516fda0086cb6c4581b41861300421bdec964e6c4a8798659536"},
+		{"y_parity_27", "0x02f8730107847735940085174876e8008252089435353535353535353535353535353535353535358806f05b59d3b2000080c01ba05e8670112af1dc69404c7b51ea9adf61e3e76e2bc61e48fb7066727d88185912a05be4628ab497516fda0086cb6c4581b41861300421bdec964e6c4a8798659536"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeEthereumTransactionHex(tt.raw); err == nil {
+				t.Errorf("transaction must be rejected")
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lukaz17/cryptotool-go/base58"
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	// Version byte prepended to the 20-byte address of Tron accounts.
+	TronAddressVersion = 0x41
+	// Length of Tron address bytes including the version byte.
+	TronAddressLength = 21
+)
+
+// A TronAccount derives Tron addresses based on Secp256k1Keypair. The address is the Ethereum
+// address prefixed with 0x41 and encoded with Base58Check, which always starts with T.
+type TronAccount struct {
+	keypair *Secp256k1Keypair
+}
+
+// Returns a TronAccount from a Secp256k1Keypair.
+func NewTronAccount(keypair *Secp256k1Keypair) *TronAccount {
+	return &TronAccount{
+		keypair: keypair,
+	}
+}
+
+// Returns the derivation path m/44'/195'/0'/0/index of address index used by Tron wallets.
+func TronDerivationPath(index uint32) string {
+	return fmt.Sprintf("m/44'/195'/0'/0/%d", index)
+}
+
+// Returns the 21 address bytes of the keypair including the 0x41 version byte.
+func (a *TronAccount) Address() stdx.Bytes {
+	address := append(stdx.Bytes{TronAddressVersion}, NewEthereumAccount(a.keypair).Address()...)
+	return address
+}
+
+// Returns the Base58Check address of the keypair starting with T.
+func (a *TronAccount) AddressStr() string {
+	return base58.CheckEncode(a.Address())
+}
+
+// Returns the EIP-55 checksum address of the keypair used by EVM tooling of Tron.
+func (a *TronAccount) EVMAddress() string {
+	return NewEthereumAccount(a.keypair).AddressStr()
+}
+
+// Returns the derivation path linked to underlying keypair.
+func (a *TronAccount) DerivationPath() string {
+	return a.keypair.derivationPath
+}
+
+// Wipes the secrets of underlying keypair from memory. The account must not be used
+// after Destroy.
+func (a *TronAccount) Destroy() {
+	a.keypair.Destroy()
+}
+
+// Returns the address of the account, the private key is redacted.
+func (a *TronAccount) String() string {
+	if a.keypair.IsDestroyed() {
+		return "TronAccount{destroyed}"
+	}
+	return fmt.Sprintf("TronAccount{address: %s, privateKey: %s}", a.AddressStr(), secmem.Redacted)
+}
+
+// Returns the same value as String so secrets are also redacted when formatted with %#v.
+func (a *TronAccount) GoString() string {
+	return a.String()
+}
+
+// Returns the 21 address bytes of a Base58Check Tron address after validating its checksum and version.
+func ParseTronAddress(address string) (stdx.Bytes, error) {
+	data, err := base58.CheckDecode(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tron address: %v", err)
+	}
+	if len(data) != TronAddressLength || data[0] != TronAddressVersion {
+		return nil, errors.New("invalid tron address: wrong length or version")
+	}
+	return stdx.Bytes(data), nil
+}
+
+// Returns true if address is a valid Base58Check Tron address.
+func IsValidTronAddress(address string) bool {
+	_, err := ParseTronAddress(address)
+	return err == nil
+}
+
+// Returns the EIP-55 checksum hex address of a Base58Check Tron address.
+func TronAddressToHex(address string) (string, error) {
+	data, err := ParseTronAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return CreateChecksumAddress(stdx.NewHex(data[1:], true).Value(), nil)
+}
+
+// Returns the Base58Check Tron address of a hex address, either a 20-byte EVM address
+// or a 21-byte Tron hex address starting with 41, with optional 0x prefix.
+func TronAddressFromHex(address string) (string, error) {
+	data, err := decodeHexField("address", address, -1)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case len(data) == TronAddressLength-1:
+		data = append(stdx.Bytes{TronAddressVersion}, data...)
+	case len(data) == TronAddressLength && data[0] == TronAddressVersion:
+	default:
+		return "", errors.New("address must be 20 bytes or 21 bytes starting with 41")
+	}
+	return base58.CheckEncode(data), nil
+}
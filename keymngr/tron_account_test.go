@@ -0,0 +1,88 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package keymngr
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestTronAccount(t *testing.T) {
+	tests := []struct {
+		name       string
+		privateKey string
+		address    string
+		hexAddress string
+	}{
+		{"tronweb", "da146374a75310b9666e834ee4ad0866d6f4035967bfc76217c5a495fff9f0d0", "TPL66VK2gCXNCD7EJg9pgJRfqcRazjhUZY", "41928c9af0651632157ef27a2cf17ca72c575a4d21"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKey, _ := hex.DecodeString(tt.privateKey)
+			account := NewTronAccount(NewSecp256k1Keypair(privateKey))
+			defer account.Destroy()
+			if account.AddressStr() != tt.address {
+				t.Errorf("invalid address. expected %s actual %s", tt.address, account.AddressStr())
+			}
+			if account.Address().HexStr() != tt.hexAddress {
+				t.Errorf("invalid hex address. expected %s actual %s", tt.hexAddress, account.Address().HexStr())
+			}
+		})
+	}
+}
+
+func TestTronAddressConversion(t *testing.T) {
+	tests := []struct {
+		name       string
+		address    string
+		hexAddress string
+	}{
+		{"usdt_contract", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", "0xa614f803B6FD780986A42c78Ec9c7f77e6DeD13C"},
+		{"tronweb", "TPL66VK2gCXNCD7EJg9pgJRfqcRazjhUZY", "0x928C9af0651632157ef27A2cf17Ca72c575a4d21"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hexAddress, err := TronAddressToHex(tt.address)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if hexAddress != tt.hexAddress {
+				t.Errorf("invalid hex address. expected %s actual %s", tt.hexAddress, hexAddress)
+			}
+			for _, input := range []string{tt.hexAddress, "41" + tt.hexAddress[2:], "0x41" + tt.hexAddress[2:]} {
+				address, err := TronAddressFromHex(input)
+				if err != nil {
+					t.Fatalf("unexpected error %v", err)
+				}
+				if address != tt.address {
+					t.Errorf("invalid address from %s. expected %s actual %s", input, tt.address, address)
+				}
+			}
+		})
+	}
+}
+
+func TestIsValidTronAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		valid   bool
+	}{
+		{"valid", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", true},
+		{"wrong_checksum", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", false},
+		{"bitcoin_address", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", false},
+		{"invalid_character", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj60", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if IsValidTronAddress(tt.address) != tt.valid {
+				t.Errorf("invalid result. expected %v", tt.valid)
+			}
+		})
+	}
+}
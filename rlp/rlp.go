@@ -0,0 +1,188 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package rlp
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+// Returns RLP encoding of value.
+// Supported types are []byte, stdx.Bytes, string, uint64, uint32, uint8, *big.Int and []interface{}
+// which may contain any of the supported types.
+func Encode(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return encodeString(v), nil
+	case stdx.Bytes:
+		return encodeString(v), nil
+	case string:
+		return encodeString([]byte(v)), nil
+	case uint64:
+		return encodeString(uintBytes(v)), nil
+	case uint32:
+		return encodeString(uintBytes(uint64(v))), nil
+	case uint8:
+		return encodeString(uintBytes(uint64(v))), nil
+	case *big.Int:
+		if v == nil {
+			return encodeString(nil), nil
+		}
+		if v.Sign() < 0 {
+			return nil, errors.New("cannot encode negative integer")
+		}
+		return encodeString(v.Bytes()), nil
+	case []interface{}:
+		var payload []byte
+		for _, item := range v {
+			encoded, err := Encode(item)
+			if err != nil {
+				return nil, err
+			}
+			payload = append(payload, encoded...)
+		}
+		return append(encodeLength(len(payload), 0xc0), payload...), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T", value)
+	}
+}
+
+// Returns the decoded value of RLP encoded data.
+// String items are decoded as []byte and list items are decoded as []interface{}.
+// Non-canonical encodings and trailing bytes are rejected.
+func Decode(data []byte) (interface{}, error) {
+	value, rest, err := decodeItem(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("trailing bytes after rlp item")
+	}
+	return value, nil
+}
+
+// Returns the unsigned integer represented by a decoded string item.
+// Leading zero bytes are rejected following canonical integer encoding.
+func DecodeUint64(data []byte) (uint64, error) {
+	if len(data) > 8 {
+		return 0, errors.New("integer overflows uint64")
+	}
+	if len(data) > 0 && data[0] == 0 {
+		return 0, errors.New("integer has leading zero bytes")
+	}
+	var result uint64
+	for _, b := range data {
+		result = result<<8 | uint64(b)
+	}
+	return result, nil
+}
+
+// Returns the big integer represented by a decoded string item.
+// Leading zero bytes are rejected following canonical integer encoding.
+func DecodeBigInt(data []byte) (*big.Int, error) {
+	if len(data) > 0 && data[0] == 0 {
+		return nil, errors.New("integer has leading zero bytes")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func decodeItem(data []byte) (interface{}, []byte, error) {
+	if len(data) == 0 {
+		return nil, nil, errors.New("unexpected end of rlp data")
+	}
+	prefix := data[0]
+	switch {
+	case prefix < 0x80:
+		return []byte{prefix}, data[1:], nil
+	case prefix < 0xc0:
+		offset, length, err := decodeLength(data, 0x80)
+		if err != nil {
+			return nil, nil, err
+		}
+		payload := data[offset : offset+length]
+		if length == 1 && payload[0] < 0x80 {
+			return nil, nil, errors.New("non-canonical single byte string")
+		}
+		return append([]byte{}, payload...), data[offset+length:], nil
+	default:
+		offset, length, err := decodeLength(data, 0xc0)
+		if err != nil {
+			return nil, nil, err
+		}
+		payload := data[offset : offset+length]
+		items := []interface{}{}
+		for len(payload) > 0 {
+			var item interface{}
+			item, payload, err = decodeItem(payload)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, data[offset+length:], nil
+	}
+}
+
+// Returns the offset and length of the payload following the prefix at data[0].
+func decodeLength(data []byte, base byte) (int, int, error) {
+	prefix := data[0] - base
+	if prefix < 56 {
+		if 1+int(prefix) > len(data) {
+			return 0, 0, errors.New("unexpected end of rlp data")
+		}
+		return 1, int(prefix), nil
+	}
+	lengthOfLength := int(prefix - 55)
+	if 1+lengthOfLength > len(data) {
+		return 0, 0, errors.New("unexpected end of rlp data")
+	}
+	if data[1] == 0 {
+		return 0, 0, errors.New("non-canonical length with leading zero bytes")
+	}
+	if lengthOfLength > 4 {
+		return 0, 0, errors.New("rlp item is too large")
+	}
+	length := 0
+	for _, b := range data[1 : 1+lengthOfLength] {
+		length = length<<8 | int(b)
+	}
+	if length < 56 {
+		return 0, 0, errors.New("non-canonical length for short payload")
+	}
+	if 1+lengthOfLength+length > len(data) {
+		return 0, 0, errors.New("unexpected end of rlp data")
+	}
+	return 1 + lengthOfLength, length, nil
+}
+
+func encodeString(data []byte) []byte {
+	if len(data) == 1 && data[0] < 0x80 {
+		return []byte{data[0]}
+	}
+	return append(encodeLength(len(data), 0x80), data...)
+}
+
+func encodeLength(length int, base byte) []byte {
+	if length < 56 {
+		return []byte{base + byte(length)}
+	}
+	lengthBytes := uintBytes(uint64(length))
+	return append([]byte{base + 55 + byte(len(lengthBytes))}, lengthBytes...)
+}
+
+// Returns big-endian bytes of value without leading zeros.
+func uintBytes(value uint64) []byte {
+	var result []byte
+	for value > 0 {
+		result = append([]byte{byte(value)}, result...)
+		value >>= 8
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package rlp
+
+import (
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	// Test cases are referenced from https://ethereum.org/en/developers/docs/data-structures-and-encoding/rlp
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{"string", "dog", "83646f67"},
+		{"list", []interface{}{"cat", "dog"}, "c88363617483646f67"},
+		{"empty_string", "", "80"},
+		{"empty_list", []interface{}{}, "c0"},
+		{"integer_zero", uint64(0), "80"},
+		{"byte_zero", []byte{0}, "00"},
+		{"integer_small", uint64(15), "0f"},
+		{"integer_large", uint64(1024), "820400"},
+		{"big_integer", big.NewInt(1024), "820400"},
+		{"nested_list", []interface{}{[]interface{}{}, []interface{}{[]interface{}{}}, []interface{}{[]interface{}{}, []interface{}{[]interface{}{}}}}, "c7c0c1c0c3c0c1c0"},
+		{"long_string", "Lorem ipsum dolor sit amet, consectetur adipisicing elit",
+			"b8384c6f72656d20697073756d20646f6c6f722073697420616d65742c20636f6e7365637465747572206164697069736963696e6720656c6974"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := Encode(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if hex.EncodeToString(encoded) != tt.expected {
+				t.Errorf("invalid encoding. expected %s actual %x", tt.expected, encoded)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected interface{}
+		isValid  bool
+	}{
+		{"string", "83646f67", []byte("dog"), true},
+		{"list", "c88363617483646f67", []interface{}{[]byte("cat"), []byte("dog")}, true},
+		{"empty_string", "80", []byte{}, true},
+		{"empty_list", "c0", []interface{}{}, true},
+		{"nested_list", "c7c0c1c0c3c0c1c0", []interface{}{[]interface{}{}, []interface{}{[]interface{}{}}, []interface{}{[]interface{}{}, []interface{}{[]interface{}{}}}}, true},
+		{"non_canonical_byte", "8105", nil, false},
+		{"non_canonical_length", "b80100", nil, false},
+		{"trailing_bytes", "83646f6700", nil, false},
+		{"truncated", "83646f", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, _ := hex.DecodeString(tt.data)
+			decoded, err := Decode(data)
+			if !tt.isValid {
+				if err == nil {
+					t.Errorf("expected error for %s", tt.data)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(decoded, tt.expected) {
+				t.Errorf("invalid decoded value. expected %v actual %v", tt.expected, decoded)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package rotation
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+	"github.com/lukaz17/cryptotool-go/vault"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	// Gas limits are generous upper bounds, unused gas is refunded.
+	EtherTransferGas = 21000
+	TokenTransferGas = 100000
+	TokenApproveGas  = 60000
+)
+
+// A Token is an ERC-20 token held or approved by the compromised account.
+// Amount is the balance to transfer, zero or nil to skip the transfer.
+// Spenders are addresses whose allowances must be revoked.
+type Token struct {
+	Symbol   string   `json:"symbol"`
+	Address  string   `json:"address"`
+	Amount   *big.Int `json:"amount,omitempty"`
+	Spenders []string `json:"spenders,omitempty"`
+}
+
+// Options contains on-chain state of the compromised account needed to build transactions.
+// Fees follow EIP-1559. If Balance is nil, the ETH sweep is listed as a manual step because
+// its value depends on the fees actually paid.
+type Options struct {
+	ChainID   *big.Int
+	Nonce     uint64
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+	Balance   *big.Int
+	Tokens    []*Token
+}
+
+// A Step is an item of the checklist. Transaction is nil for manual steps.
+type Step struct {
+	Description string
+	Transaction *keymngr.EthereumTransaction
+}
+
+// A Plan is the checklist to migrate from a compromised account to its replacement.
+// Shared are other accounts derived from the same secret, they must be rotated as well.
+// Entries are redacted and never contain secrets.
+type Plan struct {
+	Compromised *vault.Entry
+	Replacement *vault.Entry
+	Shared      []*vault.Entry
+	Steps       []*Step
+}
+
+// Returns the Plan to move assets from compromised to replacement. shared are other entries
+// derived from the same mnemonic and passphrase, as returned by Vault.SharingSecret. options
+// is required for Ethereum accounts and ignored for other account types.
+func NewPlan(compromised, replacement *vault.Entry, shared []*vault.Entry, options *Options) (*Plan, error) {
+	if compromised.Type != replacement.Type {
+		return nil, errors.New("replacement must have the same account type")
+	}
+	if strings.EqualFold(compromised.Address, replacement.Address) {
+		return nil, errors.New("replacement must have a different address")
+	}
+	plan := &Plan{
+		Compromised: compromised.Redacted(),
+		Replacement: replacement.Redacted(),
+	}
+	if compromised.Type == vault.EthereumAccount {
+		if err := plan.addEthereumSteps(options); err != nil {
+			return nil, err
+		}
+	} else {
+		plan.addManualStep(fmt.Sprintf("Sweep all UTXOs of %s to %s with a transaction spending every input, use a high fee rate to confirm first",
+			compromised.Address, replacement.Address))
+	}
+	for _, entry := range shared {
+		plan.Shared = append(plan.Shared, entry.Redacted())
+		plan.addManualStep(fmt.Sprintf("Rotate %s (%s) too, it is derived from the same mnemonic and passphrase", entry.Label, entry.Address))
+	}
+	plan.addManualStep(fmt.Sprintf("Replace %s with %s in exchange withdrawal allowlists, payment requests and invoices", compromised.Address, replacement.Address))
+	plan.addManualStep(fmt.Sprintf("Keep monitoring %s, any asset sent there later must be moved again", compromised.Address))
+	plan.addManualStep("Back up the secret of the replacement account before funds arrive, then destroy every copy of the compromised secret")
+	return plan, nil
+}
+
+// Returns the number of steps with transactions.
+func (p *Plan) TransactionCount() int {
+	count := 0
+	for _, step := range p.Steps {
+		if step.Transaction != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// Returns the checklist in human-readable format with unsigned raw transactions.
+func (p *Plan) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Rotation of %s\n", p.Compromised)
+	fmt.Fprintf(&sb, "Replacement %s\n", p.Replacement)
+	for _, entry := range p.Shared {
+		fmt.Fprintf(&sb, "Shared secret %s\n", entry)
+	}
+	for i, step := range p.Steps {
+		fmt.Fprintf(&sb, "\n[ ] %d. %s\n", i+1, step.Description)
+		if step.Transaction != nil {
+			raw, _ := step.Transaction.RawStr()
+			fmt.Fprintf(&sb, "    Unsigned: %s\n", raw)
+		}
+	}
+	return sb.String()
+}
+
+type jsonStep struct {
+	Description string `json:"description"`
+	Unsigned    string `json:"unsigned,omitempty"`
+	SigningHash string `json:"signingHash,omitempty"`
+}
+
+type jsonPlan struct {
+	Compromised *vault.Entry   `json:"compromised"`
+	Replacement *vault.Entry   `json:"replacement"`
+	Shared      []*vault.Entry `json:"shared,omitempty"`
+	Steps       []*jsonStep    `json:"steps"`
+}
+
+// Returns JSON encoding of the plan with unsigned raw transactions in 0x hex string.
+func (p *Plan) MarshalJSON() ([]byte, error) {
+	j := &jsonPlan{
+		Compromised: p.Compromised,
+		Replacement: p.Replacement,
+		Shared:      p.Shared,
+		Steps:       make([]*jsonStep, len(p.Steps)),
+	}
+	for i, step := range p.Steps {
+		j.Steps[i] = &jsonStep{Description: step.Description}
+		if step.Transaction != nil {
+			raw, err := step.Transaction.RawStr()
+			if err != nil {
+				return nil, err
+			}
+			hash, _ := step.Transaction.SigningHash()
+			j.Steps[i].Unsigned = raw
+			j.Steps[i].SigningHash = stdx.NewHex(hash, true).Value()
+		}
+	}
+	return json.Marshal(j)
+}
+
+// Adds token transfers, allowance revocations and the ETH sweep. Token transfers come first
+// because tokens are usually worth more than the ETH paying for gas.
+func (p *Plan) addEthereumSteps(options *Options) error {
+	if options == nil || options.ChainID == nil || options.ChainID.Sign() <= 0 {
+		return errors.New("chain id is required")
+	}
+	if options.GasTipCap == nil || options.GasFeeCap == nil {
+		return errors.New("maxPriorityFeePerGas and maxFeePerGas are required")
+	}
+	replacement, err := decodeAddress(p.Replacement.Address)
+	if err != nil {
+		return err
+	}
+	nonce := options.Nonce
+	totalGas := uint64(0)
+	addTransaction := func(description string, to stdx.Bytes, value *big.Int, data stdx.Bytes, gas uint64) {
+		p.Steps = append(p.Steps, &Step{
+			Description: description,
+			Transaction: &keymngr.EthereumTransaction{
+				Type:      keymngr.DynamicFeeTxType,
+				ChainID:   new(big.Int).Set(options.ChainID),
+				Nonce:     nonce,
+				GasTipCap: new(big.Int).Set(options.GasTipCap),
+				GasFeeCap: new(big.Int).Set(options.GasFeeCap),
+				Gas:       gas,
+				To:        to,
+				Value:     value,
+				Data:      data,
+			},
+		})
+		nonce++
+		totalGas += gas
+	}
+
+	for _, token := range options.Tokens {
+		contract, err := decodeAddress(token.Address)
+		if err != nil {
+			return fmt.Errorf("token %s: %w", token.Symbol, err)
+		}
+		if token.Amount == nil || token.Amount.Sign() == 0 {
+			continue
+		}
+		data, err := keymngr.ERC20TransferData(replacement, token.Amount)
+		if err != nil {
+			return fmt.Errorf("token %s: %w", token.Symbol, err)
+		}
+		addTransaction(fmt.Sprintf("Sign and broadcast transfer of %s %s (%s) to %s", token.Amount, token.Symbol, token.Address, p.Replacement.Address),
+			contract, new(big.Int), data, TokenTransferGas)
+	}
+	for _, token := range options.Tokens {
+		contract, _ := decodeAddress(token.Address)
+		for _, spender := range token.Spenders {
+			spenderBytes, err := decodeAddress(spender)
+			if err != nil {
+				return fmt.Errorf("token %s spender: %w", token.Symbol, err)
+			}
+			data, _ := keymngr.ERC20ApproveData(spenderBytes, new(big.Int))
+			addTransaction(fmt.Sprintf("Sign and broadcast revocation of %s (%s) allowance of %s", token.Symbol, token.Address, spender),
+				contract, new(big.Int), data, TokenApproveGas)
+		}
+	}
+
+	if options.Balance == nil {
+		p.addManualStep(fmt.Sprintf("Transfer remaining ETH to %s with nonce %d after all transactions above are confirmed",
+			p.Replacement.Address, nonce))
+		return nil
+	}
+	// Reserve the maximum fee of every transaction, including the sweep itself.
+	fee := new(big.Int).Mul(options.GasFeeCap, new(big.Int).SetUint64(totalGas+EtherTransferGas))
+	value := new(big.Int).Sub(options.Balance, fee)
+	if value.Sign() <= 0 {
+		if len(p.Steps) > 0 && options.Balance.Cmp(new(big.Int).Mul(options.GasFeeCap, new(big.Int).SetUint64(totalGas))) < 0 {
+			return fmt.Errorf("balance %s wei cannot pay maximum fee of %d transactions", options.Balance, len(p.Steps))
+		}
+		p.addManualStep(fmt.Sprintf("ETH balance of %s wei is too small to sweep, the transfer needs a maximum fee of %s wei",
+			options.Balance, fee))
+		return nil
+	}
+	addTransaction(fmt.Sprintf("Sign and broadcast transfer of %s wei to %s, unused fee stays in the compromised account", value, p.Replacement.Address),
+		replacement, value, nil, EtherTransferGas)
+	return nil
+}
+
+func (p *Plan) addManualStep(description string) {
+	p.Steps = append(p.Steps, &Step{Description: description})
+}
+
+func decodeAddress(address string) (stdx.Bytes, error) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil || len(decoded) != 20 {
+		return nil, fmt.Errorf("invalid address %s", address)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package rotation
+
+import (
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lukaz17/cryptotool-go/encryptor"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+	"github.com/lukaz17/cryptotool-go/vault"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+func TestNewPlan(t *testing.T) {
+	v, err := vault.Create(filepath.Join(t.TempDir(), "vault.json"), []byte("password"),
+		&vault.Options{Argon2: &encryptor.Argon2Params{Time: 1, Memory: 1024, Threads: 1}})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	defer v.Close()
+	compromised := &vault.Entry{Label: "main", Mnemonic: stdx.Bytes("repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat rescue")}
+	if err := v.Add(compromised); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	replacement, err := v.Rotate("main", "")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	usdc := "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	spender := "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"
+	gwei := big.NewInt(1000000000)
+	tests := []struct {
+		name         string
+		options      *Options
+		transactions int
+		step         string
+		isValid      bool
+	}{
+		{"tokens_and_ether", &Options{ChainID: big.NewInt(1), Nonce: 7, GasTipCap: gwei, GasFeeCap: new(big.Int).Mul(gwei, big.NewInt(30)),
+			Balance: big.NewInt(1e18), Tokens: []*Token{{"USDC", usdc, big.NewInt(5000000), []string{spender}}}}, 3, "", true},
+		{"unknown_balance", &Options{ChainID: big.NewInt(1), GasTipCap: gwei, GasFeeCap: gwei,
+			Tokens: []*Token{{"USDC", usdc, nil, []string{spender}}}}, 1, "Transfer remaining ETH", true},
+		{"dust_balance", &Options{ChainID: big.NewInt(1), GasTipCap: gwei, GasFeeCap: gwei, Balance: big.NewInt(1000)}, 0,
+			"ETH balance of 1000 wei is too small to sweep, the transfer needs a maximum fee of 21000000000000 wei", true},
+		{"insufficient_balance", &Options{ChainID: big.NewInt(1), GasTipCap: gwei, GasFeeCap: gwei, Balance: big.NewInt(1),
+			Tokens: []*Token{{"USDC", usdc, big.NewInt(1), nil}}}, 0, "", false},
+		{"invalid_token", &Options{ChainID: big.NewInt(1), GasTipCap: gwei, GasFeeCap: gwei,
+			Tokens: []*Token{{"USDC", "0x1234", big.NewInt(1), nil}}}, 0, "", false},
+		{"missing_chain_id", &Options{GasTipCap: gwei, GasFeeCap: gwei}, 0, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := NewPlan(compromised, replacement, nil, tt.options)
+			if !tt.isValid {
+				if err == nil {
+					t.Errorf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if plan.TransactionCount() != tt.transactions {
+				t.Errorf("invalid transaction count. expected %d actual %d", tt.transactions, plan.TransactionCount())
+			}
+			if !strings.Contains(plan.String(), tt.step) {
+				t.Errorf("plan does not contain step %q", tt.step)
+			}
+			keypair, _ := compromised.Keypair()
+			defer keypair.Destroy()
+			account := keymngr.NewEthereumAccount(keypair)
+			nonce := tt.options.Nonce
+			for _, step := range plan.Steps {
+				if step.Transaction == nil {
+					continue
+				}
+				if step.Transaction.Nonce != nonce {
+					t.Errorf("invalid nonce. expected %d actual %d", nonce, step.Transaction.Nonce)
+				}
+				nonce++
+				signed, err := account.SignTransaction(step.Transaction)
+				if err != nil {
+					t.Fatalf("unexpected error %v", err)
+				}
+				if sender, _ := signed.Sender(); sender != compromised.Address {
+					t.Errorf("invalid sender. expected %s actual %s", compromised.Address, sender)
+				}
+			}
+			serialized, _ := json.Marshal(plan)
+			if strings.Contains(string(serialized), "repeat") || strings.Contains(plan.String(), "repeat") {
+				t.Errorf("plan contains secret")
+			}
+		})
+	}
+}
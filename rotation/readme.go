@@ -0,0 +1,16 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package rotation provides APIs to plan the migration of assets from a compromised account
+to its replacement created by Vault.Rotate.
+
+A Plan is a checklist of steps. For Ethereum accounts, it contains unsigned transactions
+which transfer ERC-20 balances, revoke ERC-20 allowances with approve(spender, 0) and finally
+sweep the remaining ETH. Transactions use consecutive nonces and can be signed offline with
+EthereumAccount.SignTransaction. Steps which cannot be automated are listed as manual steps.
+*/
+package rotation
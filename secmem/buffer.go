@@ -0,0 +1,143 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package secmem
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+const (
+	// Text displayed instead of secrets when formatted.
+	Redacted = "[REDACTED]"
+)
+
+// ErrDestroyed is the value of the panic raised when a destroyed Buffer is used.
+var ErrDestroyed = errors.New("secmem: use of destroyed buffer")
+
+// A Buffer holds a secret in locked memory. Buffer must not be copied after use,
+// and Bytes must not be used after Destroy. Formatting a Buffer never prints its content.
+//
+// Locked memory is outside of Go heap. Destroy should be called once the secret is no
+// longer needed, a Buffer that is never destroyed is wiped and released by a finalizer.
+// Callers holding a slice returned by Bytes must keep the Buffer reachable, e.g. with
+// runtime.KeepAlive, until they are done with the slice.
+type Buffer struct {
+	data   []byte
+	memory []byte
+	locked bool
+	mu     sync.Mutex
+}
+
+// Returns a Buffer of size bytes initialized to zero.
+func NewBuffer(size int) *Buffer {
+	memory, locked := allocate(size)
+	b := &Buffer{
+		data:   memory[:size:size],
+		memory: memory,
+		locked: locked,
+	}
+	runtime.SetFinalizer(b, (*Buffer).Destroy)
+	return b
+}
+
+// Returns a Buffer containing a copy of data. data is left untouched,
+// callers should Wipe it if it is no longer needed.
+func NewBufferFrom(data []byte) *Buffer {
+	b := NewBuffer(len(data))
+	copy(b.data, data)
+	return b
+}
+
+// Returns the content of the buffer. The returned slice aliases locked memory
+// and becomes invalid after Destroy, or once the Buffer is no longer reachable.
+// Panics if the buffer is nil or destroyed, so a destroyed secret is never mistaken
+// for an empty one. Destroy must not be called while a returned slice is in use.
+func (b *Buffer) Bytes() []byte {
+	if b == nil {
+		panic(ErrDestroyed)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.memory == nil {
+		panic(ErrDestroyed)
+	}
+	return b.data
+}
+
+// Returns the length of the content.
+func (b *Buffer) Len() int {
+	if b == nil {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.data)
+}
+
+// Returns true if the buffer is backed by locked memory.
+func (b *Buffer) IsLocked() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.locked
+}
+
+// Returns true if the buffer has been destroyed.
+func (b *Buffer) IsDestroyed() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.memory == nil
+}
+
+// Wipes the content and releases the memory. It is safe to call Destroy more than once.
+func (b *Buffer) Destroy() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.memory == nil {
+		return
+	}
+	Wipe(b.memory)
+	release(b.memory, b.locked)
+	b.data = nil
+	b.memory = nil
+	b.locked = false
+}
+
+// Returns Redacted so the secret never leaks into logs.
+func (b *Buffer) String() string {
+	return Redacted
+}
+
+// Returns Redacted so the secret never leaks into logs when formatted with %#v.
+func (b *Buffer) GoString() string {
+	return Redacted
+}
+
+// Writes Redacted for every verb.
+func (b *Buffer) Format(f fmt.State, verb rune) {
+	f.Write([]byte(Redacted))
+}
+
+// Overwrites data with zeros.
+func Wipe(data []byte) {
+	for i := range data {
+		data[i] = 0
+	}
+	// Prevent the compiler from treating the writes above as dead stores.
+	runtime.KeepAlive(data)
+}
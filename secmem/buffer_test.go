@@ -0,0 +1,78 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package secmem
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestBuffer_Destroy(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", []byte{}},
+		{"key", bytes.Repeat([]byte{0xab}, 32)},
+		{"multiple_pages", bytes.Repeat([]byte{0xcd}, 10000)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBufferFrom(tt.data)
+			if !bytes.Equal(b.Bytes(), tt.data) {
+				t.Fatalf("invalid content. expected %x actual %x", tt.data, b.Bytes())
+			}
+			memory, locked := b.memory, b.IsLocked()
+			b.Destroy()
+			if !b.IsDestroyed() || b.Len() != 0 || b.IsLocked() {
+				t.Errorf("buffer is not destroyed")
+			}
+			// Locked memory is unmapped on destroy and can no longer be read.
+			if !locked && !bytes.Equal(memory, make([]byte, len(memory))) {
+				t.Errorf("memory is not wiped")
+			}
+			// Destroy must be idempotent.
+			b.Destroy()
+		})
+	}
+}
+
+func TestBuffer_Format(t *testing.T) {
+	b := NewBufferFrom([]byte("secret"))
+	defer b.Destroy()
+	tests := []struct {
+		name   string
+		format string
+	}{
+		{"v", "%v"},
+		{"plus_v", "%+v"},
+		{"sharp_v", "%#v"},
+		{"s", "%s"},
+		{"x", "%x"},
+		{"q", "%q"},
+		{"d", "%d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, value := range []interface{}{b, struct{ Key *Buffer }{b}} {
+				output := fmt.Sprintf(tt.format, value)
+				if bytes.Contains([]byte(output), []byte("secret")) || bytes.Contains([]byte(output), []byte("736563726574")) {
+					t.Errorf("secret is leaked: %s", output)
+				}
+			}
+		})
+	}
+}
+
+func TestWipe(t *testing.T) {
+	data := []byte("secret")
+	Wipe(data)
+	if !bytes.Equal(data, make([]byte, 6)) {
+		t.Errorf("data is not wiped: %x", data)
+	}
+}
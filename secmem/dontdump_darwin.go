@@ -0,0 +1,12 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+//go:build darwin
+
+package secmem
+
+// Excluding memory from core dumps is not supported by package syscall on macOS.
+func excludeFromCoreDump(memory []byte) {}
@@ -0,0 +1,18 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+//go:build linux
+
+package secmem
+
+import "syscall"
+
+// MADV_DONTDUMP is not defined by package syscall.
+const madvDontDump = 0x10
+
+func excludeFromCoreDump(memory []byte) {
+	syscall.Madvise(memory, madvDontDump)
+}
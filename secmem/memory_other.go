@@ -0,0 +1,16 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+//go:build !linux && !darwin
+
+package secmem
+
+// Memory locking is not supported, secrets are kept in heap memory and only wiped on destroy.
+func allocate(size int) ([]byte, bool) {
+	return make([]byte, size), false
+}
+
+func release(memory []byte, locked bool) {}
@@ -0,0 +1,42 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+//go:build linux || darwin
+
+package secmem
+
+import (
+	"os"
+	"syscall"
+)
+
+// Returns page aligned memory of at least size bytes outside of Go heap, locked into RAM
+// and excluded from core dumps. Falls back to heap memory if mapping or locking fails.
+func allocate(size int) ([]byte, bool) {
+	if size == 0 {
+		return []byte{}, false
+	}
+	pageSize := os.Getpagesize()
+	length := (size + pageSize - 1) / pageSize * pageSize
+	memory, err := syscall.Mmap(-1, 0, length, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return make([]byte, size), false
+	}
+	if err := syscall.Mlock(memory); err != nil {
+		syscall.Munmap(memory)
+		return make([]byte, size), false
+	}
+	excludeFromCoreDump(memory)
+	return memory, true
+}
+
+func release(memory []byte, locked bool) {
+	if !locked {
+		return
+	}
+	syscall.Munlock(memory)
+	syscall.Munmap(memory)
+}
@@ -0,0 +1,13 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package secmem provides APIs to keep secrets in memory which is locked so it is never
+swapped to disk, excluded from core dumps where supported, and wiped when destroyed.
+Memory locking may be unavailable or limited by RLIMIT_MEMLOCK, in that case buffers fall
+back to ordinary memory which is still wiped on destroy.
+*/
+package secmem
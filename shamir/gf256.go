@@ -0,0 +1,83 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package shamir
+
+import (
+	"errors"
+)
+
+// A Point is a share of a secret: the values of polynomials at X, one byte per polynomial.
+type Point struct {
+	X byte
+	Y []byte
+}
+
+var expTable, logTable = gfTables()
+
+// Returns exponent and logarithm tables of GF(256) with generator 3.
+func gfTables() ([255]byte, [256]byte) {
+	var exp [255]byte
+	var log [256]byte
+	value := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(value)
+		log[value] = byte(i)
+		// Multiply by 3 = x + 1 and reduce by the Rijndael polynomial.
+		value = (value << 1) ^ value
+		if value&0x100 != 0 {
+			value ^= 0x11b
+		}
+	}
+	return exp, log
+}
+
+// Returns the values at x of polynomials passing through points using Lagrange interpolation.
+// All points must have distinct X and values of the same length.
+func Interpolate(points []Point, x byte) ([]byte, error) {
+	if len(points) == 0 {
+		return nil, errors.New("at least one point is required")
+	}
+	length := len(points[0].Y)
+	seen := map[byte]bool{}
+	for _, point := range points {
+		if len(point.Y) != length {
+			return nil, errors.New("all points must have values of the same length")
+		}
+		if seen[point.X] {
+			return nil, errors.New("points must have distinct x coordinates")
+		}
+		seen[point.X] = true
+	}
+	for _, point := range points {
+		if point.X == x {
+			return append([]byte{}, point.Y...), nil
+		}
+	}
+
+	// Lagrange basis at x is the product of (x - xj) / (xi - xj). Subtraction is XOR in GF(256),
+	// and products are sums of logarithms.
+	logProduct := 0
+	for _, point := range points {
+		logProduct += int(logTable[point.X^x])
+	}
+	result := make([]byte, length)
+	for _, point := range points {
+		logBasis := logProduct - int(logTable[point.X^x])
+		for _, other := range points {
+			if other.X != point.X {
+				logBasis -= int(logTable[point.X^other.X])
+			}
+		}
+		logBasis = ((logBasis % 255) + 255) % 255
+		for i, y := range point.Y {
+			if y != 0 {
+				result[i] ^= expTable[(int(logTable[y])+logBasis)%255]
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,18 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package shamir provides APIs of Shamir's secret sharing over GF(256).
+Each byte of a secret is shared independently with a random polynomial whose constant term
+is the byte. The field is GF(2^8) with the Rijndael polynomial x^8 + x^4 + x^3 + x + 1,
+which is also used by SLIP-39.
+
+Split shares any secret such as a private key or the entropy of a BIP-39 mnemonic.
+Each share is encoded by Share.Mnemonic as words of the BIP-39 English wordlist with a checksum,
+so it is safe to write on paper. A digest of the secret is shared along with it, so Combine
+rejects shares of different secrets and corrupted shares.
+*/
+package shamir
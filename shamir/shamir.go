@@ -0,0 +1,149 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package shamir
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	MaxShareCount = 255
+	// Share values are the secret followed by its digest and their length is encoded in one byte.
+	MaxSecretLength  = 255 - digestLength
+	secretCoordinate = 0
+	digestLength     = 4
+)
+
+// ErrMismatch is returned when shares do not belong to the same secret.
+var ErrMismatch = errors.New("shares do not belong to the same secret")
+
+// A Share is one of the shares of a secret. Shares of the same secret have the same
+// random Identifier so shares of different secrets are never combined.
+type Share struct {
+	Identifier uint16
+	Threshold  int
+	Index      int
+	Value      stdx.Bytes
+}
+
+// Returns count shares of secret, any threshold of which recovers it.
+// secret can be up to 251 bytes, e.g. a private key or the entropy of a BIP-39 mnemonic.
+// The shared value is the secret followed by the first 4 bytes of its SHA-256, so Combine can
+// detect corrupted or mismatched shares. The digest is hidden like the secret.
+func Split(secret stdx.Bytes, threshold, count int) ([]*Share, error) {
+	if len(secret) == 0 || len(secret) > MaxSecretLength {
+		return nil, fmt.Errorf("secret must be between 1 and %d bytes", MaxSecretLength)
+	}
+	if count < 1 || count > MaxShareCount {
+		return nil, fmt.Errorf("number of shares must be between 1 and %d", MaxShareCount)
+	}
+	if threshold < 1 || threshold > count {
+		return nil, errors.New("threshold must be between 1 and the number of shares")
+	}
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	identifier := binary.BigEndian.Uint16(id[:])
+
+	// The secret is the value at 0 of random polynomials of degree threshold-1 which are
+	// determined by the secret and threshold-1 random shares.
+	data := append(append(make([]byte, 0, len(secret)+digestLength), secret...), secretDigest(secret)...)
+	defer secmem.Wipe(data)
+	points := []Point{{X: secretCoordinate, Y: data}}
+	shares := make([]*Share, count)
+	for i := range shares {
+		shares[i] = &Share{
+			Identifier: identifier,
+			Threshold:  threshold,
+			Index:      i + 1,
+		}
+	}
+	for _, share := range shares[:threshold-1] {
+		share.Value = make(stdx.Bytes, len(data))
+		if _, err := rand.Read(share.Value); err != nil {
+			return nil, err
+		}
+		points = append(points, Point{X: byte(share.Index), Y: share.Value})
+	}
+	for _, share := range shares[threshold-1:] {
+		value, err := Interpolate(points, byte(share.Index))
+		if err != nil {
+			return nil, err
+		}
+		share.Value = value
+	}
+	return shares, nil
+}
+
+// Returns the secret recovered from at least threshold shares. ErrMismatch is returned if shares
+// belong to different secrets, are inconsistent, or if the digest of the recovered secret is invalid.
+func Combine(shares []*Share) (stdx.Bytes, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("at least one share is required")
+	}
+	first := shares[0]
+	seen := map[int]bool{}
+	for _, share := range shares {
+		if share.Identifier != first.Identifier || share.Threshold != first.Threshold || len(share.Value) != len(first.Value) {
+			return nil, ErrMismatch
+		}
+		if share.Index < 1 || share.Index > MaxShareCount {
+			return nil, fmt.Errorf("invalid share index %d", share.Index)
+		}
+		if seen[share.Index] {
+			return nil, fmt.Errorf("share %d is duplicated", share.Index)
+		}
+		seen[share.Index] = true
+	}
+	if len(shares) < first.Threshold {
+		return nil, fmt.Errorf("%d shares are required, %d are provided", first.Threshold, len(shares))
+	}
+	points := make([]Point, first.Threshold)
+	for i, share := range shares[:first.Threshold] {
+		points[i] = Point{X: byte(share.Index), Y: share.Value}
+	}
+	for _, share := range shares[first.Threshold:] {
+		value, err := Interpolate(points, byte(share.Index))
+		if err != nil {
+			return nil, err
+		}
+		consistent := subtle.ConstantTimeCompare(value, share.Value) == 1
+		secmem.Wipe(value)
+		if !consistent {
+			return nil, ErrMismatch
+		}
+	}
+	data, err := Interpolate(points, secretCoordinate)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) <= digestLength {
+		secmem.Wipe(data)
+		return nil, ErrMismatch
+	}
+	secret := data[:len(data)-digestLength]
+	if subtle.ConstantTimeCompare(data[len(secret):], secretDigest(secret)) != 1 {
+		secmem.Wipe(data)
+		return nil, ErrMismatch
+	}
+	return secret, nil
+}
+
+// Returns the first digestLength bytes of SHA-256 of secret.
+func secretDigest(secret []byte) []byte {
+	digest := sha256.Sum256(secret)
+	return digest[:digestLength]
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package shamir
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		threshold int
+		count     int
+		combine   []int
+	}{
+		{"private_key", "6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355", 2, 3, []int{2, 0}},
+		{"entropy_128", "bb54aac4b89dc868ba37d9cc21b2cece", 3, 5, []int{4, 1, 3}},
+		{"entropy_256", "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f", 3, 5, []int{0, 1, 2, 3, 4}},
+		{"one_of_one", "00", 1, 1, []int{0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret, _ := hex.DecodeString(tt.secret)
+			shares, err := Split(secret, tt.threshold, tt.count)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if len(shares) != tt.count {
+				t.Fatalf("invalid number of shares. expected %d actual %d", tt.count, len(shares))
+			}
+			selected := []*Share{}
+			for _, i := range tt.combine {
+				share, err := DecodeShare(shares[i].Mnemonic())
+				if err != nil {
+					t.Fatalf("unexpected error %v", err)
+				}
+				selected = append(selected, share)
+			}
+			recovered, err := Combine(selected)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if recovered.HexStr() != tt.secret {
+				t.Errorf("invalid secret. expected %s actual %s", tt.secret, recovered.HexStr())
+			}
+			if tt.threshold > 1 {
+				if _, err := Combine(selected[:tt.threshold-1]); err == nil {
+					t.Errorf("expected error with insufficient shares")
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeShare_Corrupted(t *testing.T) {
+	secret, _ := hex.DecodeString("6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355")
+	shares, _ := Split(secret, 2, 3)
+	words := strings.Fields(shares[0].Mnemonic())
+	tests := []struct {
+		name  string
+		words []string
+	}{
+		{"first_word", append([]string{replaceWord(words[0])}, words[1:]...)},
+		{"last_word", append(append([]string{}, words[:len(words)-1]...), replaceWord(words[len(words)-1]))},
+		{"missing_word", words[1:]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeShare(strings.Join(tt.words, " ")); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
+
+func TestCombine_Mismatched(t *testing.T) {
+	secret, _ := hex.DecodeString("bb54aac4b89dc868ba37d9cc21b2cece")
+	shares, _ := Split(secret, 2, 3)
+	other := *shares[1]
+	other.Identifier ^= 1
+	tampered := *shares[2]
+	tampered.Value = append(tampered.Value[:0:0], tampered.Value...)
+	tampered.Value[0] ^= 1
+	colliding, _ := Split(secret[1:], 2, 3)
+	for _, share := range colliding {
+		share.Identifier = shares[0].Identifier
+	}
+	tests := []struct {
+		name   string
+		shares []*Share
+	}{
+		{"different_secrets", []*Share{shares[0], &other}},
+		{"inconsistent_share", []*Share{shares[0], shares[1], &tampered}},
+		{"tampered_threshold_shares", []*Share{shares[0], &tampered}},
+		{"colliding_identifier", []*Share{shares[0], colliding[1]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Combine(tt.shares); !errors.Is(err, ErrMismatch) {
+				t.Errorf("expected ErrMismatch. actual %v", err)
+			}
+		})
+	}
+}
+
+func replaceWord(word string) string {
+	if word == "abandon" {
+		return "ability"
+	}
+	return "abandon"
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package shamir
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/tforce-io/tf-golib/stdx"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	wordBits       = 11
+	headerLength   = 5
+	checksumLength = 4
+)
+
+// ErrChecksum is returned when a share mnemonic is corrupted.
+var ErrChecksum = errors.New("invalid share checksum")
+
+// Returns the share encoded as words of the BIP-39 English wordlist. The encoded data is
+// identifier (2 bytes), threshold, index, value length, value and the first 4 bytes of
+// its SHA-256 as checksum, padded with zero bits to a multiple of 11 bits.
+func (s *Share) Mnemonic() string {
+	data := make([]byte, 0, headerLength+len(s.Value)+checksumLength)
+	data = append(data, byte(s.Identifier>>8), byte(s.Identifier), byte(s.Threshold), byte(s.Index), byte(len(s.Value)))
+	data = append(data, s.Value...)
+	checksum := sha256.Sum256(data)
+	data = append(data, checksum[:checksumLength]...)
+
+	wordCount := wordCountOf(len(s.Value))
+	value := new(big.Int).SetBytes(data)
+	value.Lsh(value, uint(wordCount*wordBits-len(data)*8))
+	wordlist := bip39.GetWordList()
+	words := make([]string, wordCount)
+	mask := big.NewInt(1<<wordBits - 1)
+	index := new(big.Int)
+	for i := wordCount - 1; i >= 0; i-- {
+		words[i] = wordlist[index.And(value, mask).Int64()]
+		value.Rsh(value, wordBits)
+	}
+	return strings.Join(words, " ")
+}
+
+// Returns the share decoded from mnemonic produced by Share.Mnemonic.
+// ErrChecksum is returned if any word is changed.
+func DecodeShare(mnemonic string) (*Share, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < wordCountOf(1) {
+		return nil, errors.New("share mnemonic is too short")
+	}
+	value := new(big.Int)
+	for _, word := range words {
+		index, ok := bip39.GetWordIndex(word)
+		if !ok {
+			return nil, fmt.Errorf("invalid word %s", word)
+		}
+		value.Lsh(value, wordBits).Or(value, big.NewInt(int64(index)))
+	}
+	// The value length is read from the header to know the number of padding bits.
+	totalBits := len(words) * wordBits
+	valueLength := int(new(big.Int).Rsh(value, uint(totalBits-headerLength*8)).Int64() & 0xff)
+	if valueLength == 0 || wordCountOf(valueLength) != len(words) {
+		return nil, ErrChecksum
+	}
+	dataLength := headerLength + valueLength + checksumLength
+	paddingBits := uint(totalBits - dataLength*8)
+	padding := new(big.Int).Lsh(big.NewInt(1), paddingBits)
+	if padding.Sub(padding, big.NewInt(1)).And(padding, value).Sign() != 0 {
+		return nil, ErrChecksum
+	}
+	data := value.Rsh(value, paddingBits).FillBytes(make([]byte, dataLength))
+	checksum := sha256.Sum256(data[:dataLength-checksumLength])
+	if string(checksum[:checksumLength]) != string(data[dataLength-checksumLength:]) {
+		return nil, ErrChecksum
+	}
+	share := &Share{
+		Identifier: uint16(data[0])<<8 | uint16(data[1]),
+		Threshold:  int(data[2]),
+		Index:      int(data[3]),
+		Value:      stdx.Bytes(data[headerLength : headerLength+valueLength]),
+	}
+	if share.Threshold == 0 || share.Index == 0 {
+		return nil, errors.New("invalid share header")
+	}
+	return share, nil
+}
+
+// Returns the number of words of a share with value of valueLength bytes.
+func wordCountOf(valueLength int) int {
+	bits := (headerLength + valueLength + checksumLength) * 8
+	return (bits + wordBits - 1) / wordBits
+}
@@ -0,0 +1,17 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package slip39 provides APIs to split a master secret into mnemonic shares and to recover it
+following SLIP-39 specification, compatible with Trezor wallets.
+
+Shares are organized in groups. The master secret is recovered when the group threshold
+of groups is met, and a group is met when the member threshold of its shares is provided.
+The master secret is encrypted with the passphrase before it is split, so any passphrase
+recovers a valid but different master secret. The recovered master secret is a BIP-32 seed
+which can be derived with DeriveKey.
+*/
+package slip39
@@ -0,0 +1,153 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package slip39
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	radixBits             = 10
+	idLengthBits          = 15
+	iterationExpBits      = 4
+	metadataLengthWords   = 7
+	checksumLengthWords   = 3
+	minMnemonicLengthWord = 20
+
+	customizationString           = "shamir"
+	customizationStringExtendable = "shamir_extendable"
+)
+
+// ErrChecksum is returned when a mnemonic has an invalid checksum.
+var ErrChecksum = errors.New("invalid mnemonic checksum")
+
+// A Share is a decoded SLIP-39 mnemonic. Indexes are zero-based and thresholds are one-based.
+type Share struct {
+	Identifier        uint16
+	Extendable        bool
+	IterationExponent uint8
+	GroupIndex        int
+	GroupThreshold    int
+	GroupCount        int
+	MemberIndex       int
+	MemberThreshold   int
+	Value             stdx.Bytes
+}
+
+// Returns the Share encoded by mnemonic. Words are case-insensitive.
+func DecodeShare(mnemonic string) (*Share, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < minMnemonicLengthWord {
+		return nil, fmt.Errorf("mnemonic must have at least %d words", minMnemonicLengthWord)
+	}
+	indexes := make([]int, len(words))
+	for i, word := range words {
+		index, ok := wordIndexes[word]
+		if !ok {
+			return nil, fmt.Errorf("invalid word %s", word)
+		}
+		indexes[i] = index
+	}
+	paddingLength := (radixBits * (len(words) - metadataLengthWords)) % 16
+	if paddingLength > 8 {
+		return nil, errors.New("invalid mnemonic length")
+	}
+
+	idExp := indexes[0]<<radixBits | indexes[1]
+	share := &Share{
+		Identifier:        uint16(idExp >> (iterationExpBits + 1)),
+		Extendable:        (idExp>>iterationExpBits)&1 == 1,
+		IterationExponent: uint8(idExp & (1<<iterationExpBits - 1)),
+	}
+	if rs1024Polymod(share.customizationString(), indexes) != 1 {
+		return nil, ErrChecksum
+	}
+	params := indexes[2]<<radixBits | indexes[3]
+	share.GroupIndex = params >> 16
+	share.GroupThreshold = (params>>12)&15 + 1
+	share.GroupCount = (params>>8)&15 + 1
+	share.MemberIndex = (params >> 4) & 15
+	share.MemberThreshold = params&15 + 1
+	if share.GroupCount < share.GroupThreshold {
+		return nil, errors.New("group threshold must not exceed group count")
+	}
+
+	valueIndexes := indexes[4 : len(indexes)-checksumLengthWords]
+	if valueIndexes[0] >= 1<<(radixBits-paddingLength) {
+		return nil, errors.New("invalid mnemonic padding")
+	}
+	value := new(big.Int)
+	for _, index := range valueIndexes {
+		value.Lsh(value, radixBits)
+		value.Or(value, big.NewInt(int64(index)))
+	}
+	share.Value = make([]byte, (radixBits*len(valueIndexes)-paddingLength)/8)
+	value.FillBytes(share.Value)
+	return share, nil
+}
+
+// Returns the mnemonic of the share.
+func (s *Share) Mnemonic() string {
+	idExp := int(s.Identifier)<<(iterationExpBits+1) | int(s.IterationExponent)
+	if s.Extendable {
+		idExp |= 1 << iterationExpBits
+	}
+	params := s.GroupIndex<<16 | (s.GroupThreshold-1)<<12 | (s.GroupCount-1)<<8 | s.MemberIndex<<4 | (s.MemberThreshold - 1)
+	valueWords := (len(s.Value)*8 + radixBits - 1) / radixBits
+	indexes := []int{idExp >> radixBits, idExp & 1023, params >> radixBits, params & 1023}
+	value := new(big.Int).SetBytes(s.Value)
+	for i := valueWords - 1; i >= 0; i-- {
+		indexes = append(indexes, int(new(big.Int).Rsh(value, uint(i*radixBits)).Int64()&1023))
+	}
+	indexes = append(indexes, rs1024Checksum(s.customizationString(), indexes)...)
+	words := make([]string, len(indexes))
+	for i, index := range indexes {
+		words[i] = Wordlist[index]
+	}
+	return strings.Join(words, " ")
+}
+
+func (s *Share) customizationString() string {
+	if s.Extendable {
+		return customizationStringExtendable
+	}
+	return customizationString
+}
+
+var rs1024Generator = [10]uint32{0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009, 0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120}
+
+// Returns the remainder of the Reed-Solomon code over GF(1024) used by SLIP-39 checksum.
+func rs1024Polymod(customization string, values []int) uint32 {
+	checksum := uint32(1)
+	step := func(value uint32) {
+		top := checksum >> 20
+		checksum = (checksum&0xfffff)<<10 ^ value
+		for i := 0; i < 10; i++ {
+			if (top>>i)&1 == 1 {
+				checksum ^= rs1024Generator[i]
+			}
+		}
+	}
+	for i := 0; i < len(customization); i++ {
+		step(uint32(customization[i]))
+	}
+	for _, value := range values {
+		step(uint32(value))
+	}
+	return checksum
+}
+
+// Returns the three checksum words of values.
+func rs1024Checksum(customization string, values []int) []int {
+	polymod := rs1024Polymod(customization, append(append([]int{}, values...), 0, 0, 0)) ^ 1
+	return []int{int(polymod>>20) & 1023, int(polymod>>10) & 1023, int(polymod) & 1023}
+}
@@ -0,0 +1,308 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package slip39
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/lukaz17/cryptotool-go/shamir"
+	"github.com/tforce-io/tf-golib/stdx"
+	"github.com/tyler-smith/go-bip32"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	DefaultIterationExponent = 1
+
+	maxShareCount       = 16
+	minSecretLength     = 16
+	digestLength        = 4
+	digestIndex         = 254
+	secretIndex         = 255
+	baseIterationCount  = 10000
+	feistelRoundCount   = 4
+	maxIterationExpBits = 1<<iterationExpBits - 1
+)
+
+// ErrDigest is returned when shares are mismatched or corrupted in a way the checksum cannot detect.
+var ErrDigest = errors.New("invalid digest of the shared secret")
+
+// A Group describes how the share of a group is split among its members.
+type Group struct {
+	MemberThreshold int
+	MemberCount     int
+}
+
+// Params contains parameters of master secret encryption.
+// Extendable shares allow adding groups later with the same identifier.
+type Params struct {
+	IterationExponent uint8
+	Extendable        bool
+}
+
+// Returns recommended parameters used by Trezor wallets.
+func DefaultParams() *Params {
+	return &Params{
+		IterationExponent: DefaultIterationExponent,
+		Extendable:        true,
+	}
+}
+
+// Returns mnemonics of each group sharing masterSecret encrypted with passphrase using default parameters.
+// masterSecret must be at least 16 bytes and have an even length.
+func Split(masterSecret, passphrase []byte, groupThreshold int, groups []Group) ([][]string, error) {
+	return SplitWithParams(masterSecret, passphrase, groupThreshold, groups, DefaultParams())
+}
+
+// Returns mnemonics of each group sharing masterSecret encrypted with passphrase using provided parameters.
+func SplitWithParams(masterSecret, passphrase []byte, groupThreshold int, groups []Group, params *Params) ([][]string, error) {
+	if len(masterSecret) < minSecretLength || len(masterSecret)%2 != 0 {
+		return nil, fmt.Errorf("master secret must be at least %d bytes and have an even length", minSecretLength)
+	}
+	if err := validatePassphrase(passphrase); err != nil {
+		return nil, err
+	}
+	if params.IterationExponent > maxIterationExpBits {
+		return nil, fmt.Errorf("iteration exponent must not exceed %d", maxIterationExpBits)
+	}
+	if groupThreshold < 1 || groupThreshold > len(groups) {
+		return nil, errors.New("group threshold must be between 1 and the number of groups")
+	}
+	for _, group := range groups {
+		if group.MemberThreshold < 1 || group.MemberThreshold > group.MemberCount {
+			return nil, errors.New("member threshold must be between 1 and the number of members")
+		}
+		if group.MemberThreshold == 1 && group.MemberCount > 1 {
+			return nil, errors.New("member threshold 1 with multiple members is not allowed, use 1-of-1 instead")
+		}
+	}
+
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	identifier := binary.BigEndian.Uint16(id[:]) & (1<<idLengthBits - 1)
+	encrypted := feistel(masterSecret, passphrase, params.IterationExponent, identifier, params.Extendable, false)
+	defer secmem.Wipe(encrypted)
+	groupSecrets, err := splitSecret(groupThreshold, len(groups), encrypted)
+	if err != nil {
+		return nil, err
+	}
+	mnemonics := make([][]string, len(groups))
+	for groupIndex, group := range groups {
+		memberSecrets, err := splitSecret(group.MemberThreshold, group.MemberCount, groupSecrets[groupIndex])
+		if err != nil {
+			return nil, err
+		}
+		for memberIndex, value := range memberSecrets {
+			share := &Share{
+				Identifier:        identifier,
+				Extendable:        params.Extendable,
+				IterationExponent: params.IterationExponent,
+				GroupIndex:        groupIndex,
+				GroupThreshold:    groupThreshold,
+				GroupCount:        len(groups),
+				MemberIndex:       memberIndex,
+				MemberThreshold:   group.MemberThreshold,
+				Value:             value,
+			}
+			mnemonics[groupIndex] = append(mnemonics[groupIndex], share.Mnemonic())
+			secmem.Wipe(value)
+		}
+		secmem.Wipe(groupSecrets[groupIndex])
+	}
+	return mnemonics, nil
+}
+
+// Returns the master secret recovered from mnemonics and decrypted with passphrase.
+// Exactly the group threshold of groups must be provided, each with exactly its member threshold
+// of shares. ErrChecksum or ErrDigest is returned if a share is corrupted or mismatched.
+func Combine(mnemonics []string, passphrase []byte) (stdx.Bytes, error) {
+	if len(mnemonics) == 0 {
+		return nil, errors.New("at least one mnemonic is required")
+	}
+	if err := validatePassphrase(passphrase); err != nil {
+		return nil, err
+	}
+	shares := make([]*Share, len(mnemonics))
+	for i, mnemonic := range mnemonics {
+		share, err := DecodeShare(mnemonic)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = share
+	}
+	first := shares[0]
+	groups := map[int][]*Share{}
+	for _, share := range shares {
+		if share.Identifier != first.Identifier || share.Extendable != first.Extendable ||
+			share.IterationExponent != first.IterationExponent {
+			return nil, errors.New("all mnemonics must belong to the same secret")
+		}
+		if share.GroupThreshold != first.GroupThreshold || share.GroupCount != first.GroupCount {
+			return nil, errors.New("all mnemonics must have the same group threshold and group count")
+		}
+		if len(share.Value) != len(first.Value) {
+			return nil, errors.New("all mnemonics must have the same length")
+		}
+		groups[share.GroupIndex] = append(groups[share.GroupIndex], share)
+	}
+	if len(groups) != first.GroupThreshold {
+		return nil, fmt.Errorf("%d groups are required, %d are provided", first.GroupThreshold, len(groups))
+	}
+
+	groupPoints := make([]shamir.Point, 0, len(groups))
+	for groupIndex, members := range groups {
+		memberPoints := make([]shamir.Point, 0, len(members))
+		seen := map[int]bool{}
+		for _, member := range members {
+			if member.MemberThreshold != members[0].MemberThreshold {
+				return nil, errors.New("all mnemonics of a group must have the same member threshold")
+			}
+			if seen[member.MemberIndex] {
+				return nil, errors.New("mnemonics must have distinct member indexes")
+			}
+			seen[member.MemberIndex] = true
+			memberPoints = append(memberPoints, shamir.Point{X: byte(member.MemberIndex), Y: member.Value})
+		}
+		if len(members) != members[0].MemberThreshold {
+			return nil, fmt.Errorf("group %d requires %d mnemonics, %d are provided", groupIndex+1, members[0].MemberThreshold, len(members))
+		}
+		groupSecret, err := recoverSecret(memberPoints)
+		if err != nil {
+			return nil, err
+		}
+		defer secmem.Wipe(groupSecret)
+		groupPoints = append(groupPoints, shamir.Point{X: byte(groupIndex), Y: groupSecret})
+	}
+	encrypted, err := recoverSecret(groupPoints)
+	if err != nil {
+		return nil, err
+	}
+	defer secmem.Wipe(encrypted)
+	return feistel(encrypted, passphrase, first.IterationExponent, first.Identifier, first.Extendable, true), nil
+}
+
+// Returns the key at derivationPath derived from the master secret recovered from mnemonics,
+// using the same BIP-32 derivation as keymngr.DeriveKeyFromMnemonic.
+func DeriveKey(mnemonics []string, passphrase []byte, derivationPath string) (*bip32.Key, error) {
+	masterSecret, err := Combine(mnemonics, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer secmem.Wipe(masterSecret)
+	return keymngr.DeriveKeyFromSeed(masterSecret, derivationPath)
+}
+
+// Returns shares of secret, any threshold of which recovers it. Unless threshold is 1,
+// a digest of the secret is shared at digestIndex so recovery can detect invalid shares.
+func splitSecret(threshold, count int, secret []byte) ([][]byte, error) {
+	if count > maxShareCount {
+		return nil, fmt.Errorf("number of shares must not exceed %d", maxShareCount)
+	}
+	shares := make([][]byte, count)
+	if threshold == 1 {
+		for i := range shares {
+			shares[i] = append([]byte{}, secret...)
+		}
+		return shares, nil
+	}
+	points := make([]shamir.Point, 0, threshold)
+	for i := 0; i < threshold-2; i++ {
+		shares[i] = make([]byte, len(secret))
+		if _, err := rand.Read(shares[i]); err != nil {
+			return nil, err
+		}
+		points = append(points, shamir.Point{X: byte(i), Y: shares[i]})
+	}
+	randomPart := make([]byte, len(secret)-digestLength)
+	if _, err := rand.Read(randomPart); err != nil {
+		return nil, err
+	}
+	digestShare := append(createDigest(randomPart, secret), randomPart...)
+	defer secmem.Wipe(digestShare)
+	points = append(points, shamir.Point{X: digestIndex, Y: digestShare}, shamir.Point{X: secretIndex, Y: secret})
+	for i := threshold - 2; i < count; i++ {
+		share, err := shamir.Interpolate(points, byte(i))
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = share
+	}
+	return shares, nil
+}
+
+// Returns the secret shared by points and verifies its digest.
+func recoverSecret(points []shamir.Point) ([]byte, error) {
+	if len(points) == 1 {
+		return append([]byte{}, points[0].Y...), nil
+	}
+	secret, err := shamir.Interpolate(points, secretIndex)
+	if err != nil {
+		return nil, err
+	}
+	digestShare, err := shamir.Interpolate(points, digestIndex)
+	if err != nil {
+		return nil, err
+	}
+	defer secmem.Wipe(digestShare)
+	if !hmac.Equal(digestShare[:digestLength], createDigest(digestShare[digestLength:], secret)) {
+		secmem.Wipe(secret)
+		return nil, ErrDigest
+	}
+	return secret, nil
+}
+
+func createDigest(randomPart, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomPart)
+	mac.Write(secret)
+	return mac.Sum(nil)[:digestLength]
+}
+
+// Returns data encrypted or decrypted with a 4-round Feistel network whose round function
+// is PBKDF2-HMAC-SHA256 keyed by the round index and passphrase.
+func feistel(data, passphrase []byte, iterationExponent uint8, identifier uint16, extendable, decrypt bool) []byte {
+	half := len(data) / 2
+	left := append([]byte{}, data[:half]...)
+	right := append([]byte{}, data[half:]...)
+	salt := []byte{}
+	if !extendable {
+		salt = append([]byte(customizationString), byte(identifier>>8), byte(identifier))
+	}
+	iterations := (baseIterationCount << iterationExponent) / feistelRoundCount
+	for round := 0; round < feistelRoundCount; round++ {
+		index := round
+		if decrypt {
+			index = feistelRoundCount - 1 - round
+		}
+		key := append([]byte{byte(index)}, passphrase...)
+		f := pbkdf2.Key(key, append(append([]byte{}, salt...), right...), iterations, len(right), sha256.New)
+		for i := range left {
+			left[i] ^= f[i]
+		}
+		left, right = right, left
+		secmem.Wipe(key)
+	}
+	return append(right, left...)
+}
+
+// SLIP-39 passphrases are limited to printable ASCII characters.
+func validatePassphrase(passphrase []byte) error {
+	for _, c := range passphrase {
+		if c < 32 || c > 126 {
+			return errors.New("passphrase must contain only printable ASCII characters")
+		}
+	}
+	return nil
+}
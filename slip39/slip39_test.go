@@ -0,0 +1,123 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package slip39
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+func TestCombine_Vectors(t *testing.T) {
+	data, err := os.ReadFile("testdata/vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vectors [][]interface{}
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatal(err)
+	}
+	for _, vector := range vectors {
+		name := vector[0].(string)
+		mnemonics := []string{}
+		for _, mnemonic := range vector[1].([]interface{}) {
+			mnemonics = append(mnemonics, mnemonic.(string))
+		}
+		masterSecret := vector[2].(string)
+		xprv := vector[3].(string)
+		t.Run(name, func(t *testing.T) {
+			secret, err := Combine(mnemonics, []byte("TREZOR"))
+			if masterSecret == "" {
+				if err == nil {
+					t.Errorf("expected error. actual %s", secret.HexStr())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if secret.HexStr() != masterSecret {
+				t.Errorf("invalid master secret. expected %s actual %s", masterSecret, secret.HexStr())
+			}
+			key, _ := keymngr.DeriveKeyFromSeed(secret, "m")
+			if key.String() != xprv {
+				t.Errorf("invalid xprv. expected %s actual %s", xprv, key.String())
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name           string
+		masterSecret   string
+		passphrase     string
+		groupThreshold int
+		groups         []Group
+		params         *Params
+		combine        [][2]int
+	}{
+		{"single", "bb54aac4b89dc868ba37d9cc21b2cece", "", 1, []Group{{1, 1}}, DefaultParams(), [][2]int{{0, 0}}},
+		{"2_of_3", "bb54aac4b89dc868ba37d9cc21b2cece", "TREZOR", 1, []Group{{2, 3}}, DefaultParams(), [][2]int{{0, 2}, {0, 0}}},
+		{"non_extendable", "0c94ed5d1f16c4b4a43e73e8e4ed6b0c9ae9d1fb6b1a49b7e7cc5bdd1e0d8c11", "TREZOR", 1, []Group{{3, 5}}, &Params{0, false}, [][2]int{{0, 4}, {0, 1}, {0, 3}}},
+		{"groups", "bb54aac4b89dc868ba37d9cc21b2cece", "", 2, []Group{{1, 1}, {2, 3}, {3, 5}}, DefaultParams(), [][2]int{{2, 0}, {2, 4}, {0, 0}, {2, 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			masterSecret, _ := hex.DecodeString(tt.masterSecret)
+			mnemonics, err := SplitWithParams(masterSecret, []byte(tt.passphrase), tt.groupThreshold, tt.groups, tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			for i, group := range tt.groups {
+				if len(mnemonics[i]) != group.MemberCount {
+					t.Fatalf("invalid number of mnemonics of group %d. expected %d actual %d", i, group.MemberCount, len(mnemonics[i]))
+				}
+			}
+			selected := []string{}
+			for _, c := range tt.combine {
+				selected = append(selected, mnemonics[c[0]][c[1]])
+			}
+			secret, err := Combine(selected, []byte(tt.passphrase))
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if secret.HexStr() != tt.masterSecret {
+				t.Errorf("invalid master secret. expected %s actual %s", tt.masterSecret, secret.HexStr())
+			}
+			if _, err := Combine(selected[1:], []byte(tt.passphrase)); len(selected) > 1 && err == nil {
+				t.Errorf("expected error with insufficient mnemonics")
+			}
+		})
+	}
+}
+
+func TestSplit_Invalid(t *testing.T) {
+	tests := []struct {
+		name           string
+		masterSecret   string
+		groupThreshold int
+		groups         []Group
+	}{
+		{"short_secret", "bb54aac4b89dc868ba37d9cc21b2ce", 1, []Group{{1, 1}}},
+		{"odd_secret", "bb54aac4b89dc868ba37d9cc21b2cece00", 1, []Group{{1, 1}}},
+		{"1_of_2", "bb54aac4b89dc868ba37d9cc21b2cece", 1, []Group{{1, 2}}},
+		{"too_many_shares", "bb54aac4b89dc868ba37d9cc21b2cece", 1, []Group{{2, 17}}},
+		{"group_threshold", "bb54aac4b89dc868ba37d9cc21b2cece", 2, []Group{{2, 3}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			masterSecret, _ := hex.DecodeString(tt.masterSecret)
+			if _, err := Split(masterSecret, nil, tt.groupThreshold, tt.groups); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
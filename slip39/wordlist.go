@@ -0,0 +1,26 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package slip39
+
+import (
+	_ "embed"
+	"strings"
+)
+
+//go:embed wordlist.txt
+var wordlistText string
+
+// Wordlist contains the 1024 words of SLIP-39. The first four letters of each word are unique.
+var Wordlist = strings.Fields(wordlistText)
+
+var wordIndexes = func() map[string]int {
+	indexes := make(map[string]int, len(Wordlist))
+	for i, word := range Wordlist {
+		indexes[word] = i
+	}
+	return indexes
+}()
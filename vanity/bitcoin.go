@@ -0,0 +1,236 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/lukaz17/cryptotool-go/base58"
+	"github.com/lukaz17/cryptotool-go/bech32"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+const (
+	// Length of hash160 and checksum encoded after the version byte of P2PKH address.
+	p2pkhPayloadLength = 24
+	// Number of Bech32 characters encoding the witness program.
+	p2wpkhProgramLength  = 32
+	p2trProgramLength    = 52
+	bech32ChecksumLength = 6
+)
+
+// bitcoinGenerator encodes the compressed public keys of a secp256k1Walk as addressType.
+type bitcoinGenerator struct {
+	secp256k1Walk
+	addressType keymngr.BitcoinAddressType
+	network     *keymngr.BitcoinNetwork
+	address     string
+	pubkey      []byte
+}
+
+// Returns a GeneratorFactory for Bitcoin addresses of addressType on network.
+// Each worker starts from a random private key read from crypto/rand and jumps to a new one
+// after every result. Taproot addresses require a scalar multiplication per candidate to compute the
+// output key, so they are searched several times slower than other types.
+func NewBitcoinGenerator(addressType keymngr.BitcoinAddressType, network *keymngr.BitcoinNetwork) (GeneratorFactory, error) {
+	if addressType != keymngr.P2PKH && addressType != keymngr.P2WPKH && addressType != keymngr.P2TR {
+		return nil, fmt.Errorf("unsupported address type %s", addressType)
+	}
+	if network == nil {
+		return nil, errors.New("network is required")
+	}
+	return func() (Generator, error) {
+		g := &bitcoinGenerator{
+			addressType: addressType,
+			network:     network,
+			pubkey:      make([]byte, 33),
+		}
+		if err := g.seed(); err != nil {
+			return nil, err
+		}
+		return g, nil
+	}, nil
+}
+
+func (g *bitcoinGenerator) Next() string {
+	if !g.next() {
+		return ""
+	}
+	g.pubkey[0] = 0x2 + byte(g.y.Bit(0))
+	g.x.FillBytes(g.pubkey[1:])
+	g.address, _ = keymngr.BitcoinAddressFromPublicKey(g.pubkey, g.addressType, g.network)
+	return g.address
+}
+
+func (g *bitcoinGenerator) Result() *Result {
+	return &Result{
+		Address: g.address,
+		Key:     g.emit(),
+	}
+}
+
+func (g *bitcoinGenerator) generatesPrivateKeys() {}
+
+// A BitcoinMatcher matches Bitcoin addresses by prefix and suffix.
+// Base58 patterns are case-sensitive, Bech32 patterns are case-insensitive.
+type BitcoinMatcher struct {
+	prefix     string
+	suffix     string
+	difficulty float64
+}
+
+// Returns a BitcoinMatcher for addresses of addressType on network. prefix must include
+// the fixed leading part of the address: 1 for P2PKH, bc1q for P2WPKH and bc1p for P2TR on mainnet.
+// Patterns with characters outside the address alphabet or which can never match are rejected.
+func NewBitcoinMatcher(addressType keymngr.BitcoinAddressType, network *keymngr.BitcoinNetwork, prefix, suffix string) (*BitcoinMatcher, error) {
+	if network == nil {
+		return nil, errors.New("network is required")
+	}
+	var difficulty float64
+	var err error
+	switch addressType {
+	case keymngr.P2PKH:
+		difficulty, err = base58Difficulty(network.PubKeyHashVersion, prefix, suffix)
+	case keymngr.P2WPKH:
+		prefix, suffix = strings.ToLower(prefix), strings.ToLower(suffix)
+		difficulty, err = bech32Difficulty(network.Bech32HRP+"1q", p2wpkhProgramLength, prefix, suffix)
+	case keymngr.P2TR:
+		prefix, suffix = strings.ToLower(prefix), strings.ToLower(suffix)
+		difficulty, err = bech32Difficulty(network.Bech32HRP+"1p", p2trProgramLength, prefix, suffix)
+	default:
+		return nil, fmt.Errorf("unsupported address type %s", addressType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &BitcoinMatcher{
+		prefix:     prefix,
+		suffix:     suffix,
+		difficulty: difficulty,
+	}, nil
+}
+
+// Returns true if address starts with prefix and ends with suffix.
+func (m *BitcoinMatcher) Match(address string) bool {
+	return strings.HasPrefix(address, m.prefix) && strings.HasSuffix(address, m.suffix)
+}
+
+// Returns expected attempts to find one match.
+func (m *BitcoinMatcher) Difficulty() float64 {
+	return m.difficulty
+}
+
+// Returns expected attempts for a P2PKH pattern. Base58 digits are not uniformly distributed
+// at the start of an address, so the probability is computed by counting payloads whose
+// encoding starts with prefix. Each leading zero byte of the payload is encoded as 1.
+func base58Difficulty(version byte, prefix, suffix string) (float64, error) {
+	for _, pattern := range []string{prefix, suffix} {
+		for i := 0; i < len(pattern); i++ {
+			if !base58.IsValidChar(pattern[i]) {
+				return 0, fmt.Errorf("invalid character %q in pattern, Base58 does not use 0, O, I and l", pattern[i])
+			}
+		}
+	}
+	total := new(big.Int).Lsh(big.NewInt(1), 8*p2pkhPayloadLength)
+	var count *big.Int
+	if version == 0 {
+		expected := base58.Alphabet[:1]
+		if !strings.HasPrefix(prefix, expected) {
+			return 0, fmt.Errorf("pattern must start with %s", expected)
+		}
+		count = base58PayloadPrefixCount(prefix[1:], p2pkhPayloadLength)
+	} else {
+		if prefix == "" {
+			return 0, errors.New("pattern must start with the version character")
+		}
+		lo := new(big.Int).Lsh(big.NewInt(int64(version)), 8*p2pkhPayloadLength)
+		hi := new(big.Int).Lsh(big.NewInt(int64(version)+1), 8*p2pkhPayloadLength)
+		count = base58PrefixCount(prefix, lo, hi)
+	}
+	if count.Sign() == 0 {
+		return 0, errors.New("pattern can never match")
+	}
+	probability, _ := new(big.Float).Quo(new(big.Float).SetInt(count), new(big.Float).SetInt(total)).Float64()
+	// The last digits of a large uniform number are close to uniform.
+	probability *= math.Pow(58, -float64(len(suffix)))
+	return 1 / probability, nil
+}
+
+// Returns the number of payloads of payloadLength bytes whose Base58 encoding starts with prefix.
+// Each leading zero byte of the payload is encoded as 1.
+func base58PayloadPrefixCount(prefix string, payloadLength int) *big.Int {
+	zeros := len(prefix) - len(strings.TrimLeft(prefix, base58.Alphabet[:1]))
+	if zeros > payloadLength {
+		return new(big.Int)
+	}
+	if zeros == len(prefix) {
+		// Only leading zero bytes are required.
+		return new(big.Int).Lsh(big.NewInt(1), uint(8*(payloadLength-zeros)))
+	}
+	lo := new(big.Int).Lsh(big.NewInt(1), uint(8*(payloadLength-zeros-1)))
+	hi := new(big.Int).Lsh(big.NewInt(1), uint(8*(payloadLength-zeros)))
+	return base58PrefixCount(prefix[zeros:], lo, hi)
+}
+
+// Returns the number of integers in [lo, hi) whose Base58 encoding starts with prefix.
+// prefix must not start with the zero digit.
+func base58PrefixCount(prefix string, lo, hi *big.Int) *big.Int {
+	base := big.NewInt(58)
+	value := new(big.Int)
+	for i := 0; i < len(prefix); i++ {
+		value.Mul(value, base)
+		value.Add(value, big.NewInt(int64(strings.IndexByte(base58.Alphabet, prefix[i]))))
+	}
+	count := new(big.Int)
+	if prefix == "" || prefix[0] == base58.Alphabet[0] {
+		return count
+	}
+	// Numbers with length digits starting with prefix are [value*58^k, (value+1)*58^k).
+	scale := big.NewInt(1)
+	for {
+		start := new(big.Int).Mul(value, scale)
+		if start.Cmp(hi) >= 0 {
+			return count
+		}
+		end := new(big.Int).Add(value, big.NewInt(1))
+		end.Mul(end, scale)
+		if start.Cmp(lo) < 0 {
+			start.Set(lo)
+		}
+		if end.Cmp(hi) > 0 {
+			end.Set(hi)
+		}
+		if end.Cmp(start) > 0 {
+			count.Add(count, end.Sub(end, start))
+		}
+		scale.Mul(scale, base)
+	}
+}
+
+// Returns expected attempts for a Bech32 pattern. Every character of the witness program
+// and checksum is uniformly distributed.
+func bech32Difficulty(fixed string, programLength int, prefix, suffix string) (float64, error) {
+	if !strings.HasPrefix(prefix, fixed) {
+		return 0, fmt.Errorf("pattern must start with %s", fixed)
+	}
+	body := prefix[len(fixed):]
+	for _, pattern := range []string{body, suffix} {
+		for i := 0; i < len(pattern); i++ {
+			if !bech32.IsValidChar(pattern[i]) {
+				return 0, fmt.Errorf("invalid character %q in pattern, Bech32 does not use 1, b, i and o", pattern[i])
+			}
+		}
+	}
+	if len(body)+len(suffix) > programLength+bech32ChecksumLength {
+		return 0, errors.New("pattern is longer than address")
+	}
+	return math.Pow(32, float64(len(body)+len(suffix))), nil
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"context"
+	"crypto/rand"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/lukaz17/cryptotool-go/base58"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+func TestSearch_Bitcoin(t *testing.T) {
+	tests := []struct {
+		name        string
+		addressType keymngr.BitcoinAddressType
+		network     *keymngr.BitcoinNetwork
+		prefix      string
+		suffix      string
+	}{
+		{"p2pkh", keymngr.P2PKH, keymngr.BitcoinMainnet, "1A", ""},
+		{"p2pkh_testnet", keymngr.P2PKH, keymngr.BitcoinTestnet, "n", "z"},
+		{"p2wpkh", keymngr.P2WPKH, keymngr.BitcoinMainnet, "BC1QA", ""},
+		{"p2tr", keymngr.P2TR, keymngr.BitcoinMainnet, "bc1p", "x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory, err := NewBitcoinGenerator(tt.addressType, tt.network)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			matcher, err := NewBitcoinMatcher(tt.addressType, tt.network, tt.prefix, tt.suffix)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			results, err := Search(context.Background(), factory, matcher, &Options{Workers: 2})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			result := results[0]
+			account := keymngr.NewBitcoinAccount(keymngr.NewSecp256k1Keypair(result.Key), tt.network)
+			if account.Address(tt.addressType) != result.Address {
+				t.Errorf("private key does not match address. expected %s actual %s", result.Address, account.Address(tt.addressType))
+			}
+			if !matcher.Match(result.Address) {
+				t.Errorf("address does not match pattern %s", result.Address)
+			}
+		})
+	}
+}
+
+func TestNewBitcoinMatcher_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		addressType keymngr.BitcoinAddressType
+		prefix      string
+		suffix      string
+	}{
+		{"base58_zero", keymngr.P2PKH, "10", ""},
+		{"base58_upper_o", keymngr.P2PKH, "1O", ""},
+		{"base58_upper_i", keymngr.P2PKH, "1I", ""},
+		{"base58_lower_l", keymngr.P2PKH, "1", "l"},
+		{"base58_wrong_version", keymngr.P2PKH, "3abc", ""},
+		{"base58_too_long", keymngr.P2PKH, "1" + strings.Repeat("z", 40), ""},
+		{"bech32_b", keymngr.P2WPKH, "bc1qb", ""},
+		{"bech32_i", keymngr.P2WPKH, "bc1q", "i"},
+		{"bech32_one", keymngr.P2TR, "bc1p1", ""},
+		{"bech32_wrong_type", keymngr.P2TR, "bc1qa", ""},
+		{"bech32_too_long", keymngr.P2WPKH, "bc1q" + strings.Repeat("a", 39), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewBitcoinMatcher(tt.addressType, keymngr.BitcoinMainnet, tt.prefix, tt.suffix); err == nil {
+				t.Errorf("expected error for %s...%s", tt.prefix, tt.suffix)
+			}
+		})
+	}
+}
+
+func TestBitcoinMatcher_Difficulty(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		expected float64
+	}{
+		{"version_only", "1", 1},
+		{"one_zero_byte", "11", 256},
+		{"two_zero_bytes", "111", 65536},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := NewBitcoinMatcher(keymngr.P2PKH, keymngr.BitcoinMainnet, tt.prefix, "")
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if math.Abs(matcher.Difficulty()-tt.expected) > tt.expected*1e-9 {
+				t.Errorf("invalid difficulty. expected %v actual %v", tt.expected, matcher.Difficulty())
+			}
+		})
+	}
+
+	// Second character of P2PKH address is not uniform, compare estimation with sampling.
+	samples := 20000
+	counts := map[byte]int{}
+	payload := make([]byte, 25)
+	for i := 0; i < samples; i++ {
+		rand.Read(payload[1:])
+		counts[base58.Encode(payload)[1]]++
+	}
+	for _, c := range []byte{'2', 'A', 'H', 'P'} {
+		matcher, _ := NewBitcoinMatcher(keymngr.P2PKH, keymngr.BitcoinMainnet, "1"+string(c), "")
+		expected := float64(samples) / matcher.Difficulty()
+		actual := float64(counts[c])
+		if math.Abs(actual-expected) > 5*math.Sqrt(expected)+5 {
+			t.Errorf("difficulty of 1%c does not match sampling. expected about %v actual %v", c, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+	"github.com/tforce-io/tf-golib/stdx"
+	"golang.org/x/crypto/sha3"
+)
+
+// create2Generator walks consecutive salts from a random starting point.
+// The last 8 bytes of the salt are used as counter, remaining bytes after the
+// fixed prefix are random.
+type create2Generator struct {
+	data    []byte
+	counter uint64
+	address []byte
+}
+
+// Returns a GeneratorFactory for contracts deployed by deployer using CREATE2 opcode.
+// If saltPrefix is not empty, all salts will start with it. Common CREATE2 factories require
+// the first 20 bytes of the salt to be the caller address to prevent front-running.
+// saltPrefix must not be longer than 24 bytes so there is room for the counter.
+func NewCreate2Generator(deployer, initCodeHash, saltPrefix stdx.Bytes) (GeneratorFactory, error) {
+	if len(deployer) != 20 {
+		return nil, errors.New("deployer address must be 20 bytes")
+	}
+	if len(initCodeHash) != 32 {
+		return nil, errors.New("init code hash must be 32 bytes")
+	}
+	if len(saltPrefix) > 24 {
+		return nil, errors.New("salt prefix must not be longer than 24 bytes")
+	}
+	return func() (Generator, error) {
+		// 0xff ++ deployer ++ salt ++ initCodeHash
+		data := make([]byte, 85)
+		data[0] = 0xff
+		copy(data[1:21], deployer)
+		salt := data[21:53]
+		copy(salt, saltPrefix)
+		if _, err := rand.Read(salt[len(saltPrefix):]); err != nil {
+			return nil, err
+		}
+		copy(data[53:], initCodeHash)
+		return &create2Generator{
+			data:    data,
+			counter: binary.BigEndian.Uint64(salt[24:]),
+		}, nil
+	}, nil
+}
+
+func (g *create2Generator) Next() string {
+	g.counter++
+	binary.BigEndian.PutUint64(g.data[45:53], g.counter)
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(g.data)
+	g.address = hasher.Sum(nil)[12:]
+	return hex.EncodeToString(g.address)
+}
+
+func (g *create2Generator) Result() *Result {
+	address, _ := keymngr.CreateChecksumAddress(hex.EncodeToString(g.address), nil)
+	salt := make([]byte, 32)
+	copy(salt, g.data[21:53])
+	return &Result{
+		Address: "0x" + address,
+		Key:     stdx.Bytes(salt),
+	}
+}
@@ -0,0 +1,383 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+const (
+	taskPath   = "/task"
+	reportPath = "/report"
+)
+
+// A Coordinator distributes a search to workers on other machines over HTTP.
+// Workers fetch the task from GET /task and send attempt counts and hits to POST /report.
+// Every worker starts from independent random offsets, so the search space is split
+// without coordination. Each hit is verified before it is accepted, rejected hits are
+// returned in the response of the report so the worker drops them.
+//
+// The API is not authenticated, anyone who can reach it can read the task and report
+// attempts. Serve it on a trusted network only, e.g. localhost, a VPN or behind a reverse
+// proxy which authenticates workers.
+//
+// Only split-key and CREATE2 targets can be distributed. With split-key, workers
+// only know the public key of the requester and report offsets, so neither workers
+// nor the network ever see the final private key.
+type Coordinator struct {
+	config   JobConfig
+	matcher  Matcher
+	start    time.Time
+	mux      *http.ServeMux
+	mu       sync.Mutex
+	attempts uint64
+	workers  int
+	results  []*Result
+	done     chan struct{}
+}
+
+// Returns a Coordinator for the search described by config.
+func NewCoordinator(config *JobConfig) (*Coordinator, error) {
+	if config.Target != SplitKeyTarget && config.Target != Create2Target {
+		return nil, fmt.Errorf("target %s cannot be distributed, use %s target so private keys never leave this machine", config.Target, SplitKeyTarget)
+	}
+	matcher, err := config.Matcher()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := config.Generator(); err != nil {
+		return nil, err
+	}
+	c := &Coordinator{
+		config:  *config,
+		matcher: matcher,
+		start:   time.Now(),
+		mux:     http.NewServeMux(),
+		results: []*Result{},
+		done:    make(chan struct{}),
+	}
+	if c.config.MaxResults <= 0 {
+		c.config.MaxResults = 1
+	}
+	c.mux.HandleFunc(taskPath, c.handleTask)
+	c.mux.HandleFunc(reportPath, c.handleReport)
+	return c, nil
+}
+
+// Serves the coordinator API.
+func (c *Coordinator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mux.ServeHTTP(w, r)
+}
+
+// Returns a channel which is closed when enough results are found.
+func (c *Coordinator) Done() <-chan struct{} {
+	return c.done
+}
+
+// Returns combined progress reported by all workers.
+func (c *Coordinator) Progress() *Progress {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &Progress{
+		Attempts:   c.attempts,
+		Elapsed:    time.Since(c.start),
+		Difficulty: c.matcher.Difficulty(),
+		Found:      len(c.results),
+	}
+}
+
+// Returns the number of workers which have fetched the task.
+func (c *Coordinator) Workers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.workers
+}
+
+// Returns verified results. For split-key target, Result.Key is the offset
+// to be combined with the private key using CombineSplitKey.
+func (c *Coordinator) Results() []*Result {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*Result{}, c.results...)
+}
+
+func (c *Coordinator) handleTask(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	c.mu.Lock()
+	c.workers++
+	response := &taskResponse{
+		WorkerID: c.workers,
+		Config:   c.config,
+		Done:     len(c.results) >= c.config.MaxResults,
+	}
+	c.mu.Unlock()
+	writeJSON(w, response)
+}
+
+func (c *Coordinator) handleReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var report reportRequest
+	if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+		http.Error(w, "invalid report", http.StatusBadRequest)
+		return
+	}
+	results := make([]*Result, 0, len(report.Results))
+	rejected := []*rejectedHit{}
+	for _, hit := range report.Results {
+		result, err := c.verify(hit)
+		if err != nil {
+			rejected = append(rejected, &rejectedHit{Address: hit.Address, Reason: err.Error()})
+			continue
+		}
+		results = append(results, result)
+	}
+
+	c.mu.Lock()
+	c.attempts += report.Attempts
+	wasDone := len(c.results) >= c.config.MaxResults
+	for _, result := range results {
+		if len(c.results) >= c.config.MaxResults {
+			break
+		}
+		if !c.hasResult(result.Address) {
+			result.Attempts = c.attempts
+			c.results = append(c.results, result)
+		}
+	}
+	isDone := len(c.results) >= c.config.MaxResults
+	c.mu.Unlock()
+	if isDone && !wasDone {
+		close(c.done)
+	}
+	writeJSON(w, &reportResponse{Done: isDone, Rejected: rejected})
+}
+
+// Returns the Result of hit after recomputing its address from the key.
+func (c *Coordinator) verify(hit *jsonResult) (*Result, error) {
+	if hit == nil {
+		return nil, errors.New("empty hit")
+	}
+	key, err := hex.DecodeString(hit.Key)
+	if err != nil {
+		return nil, errors.New("invalid key")
+	}
+	var address string
+	switch c.config.Target {
+	case SplitKeyTarget:
+		publicKey, _ := decodeHex(c.config.PublicKey)
+		address, err = SplitKeyAddress(publicKey, key)
+	case Create2Target:
+		address, err = keymngr.Create2AddressStr(c.config.Deployer, hit.Key, c.config.InitCodeHash)
+		if err == nil && !strings.HasPrefix(strings.ToLower(hit.Key), strings.ToLower(strings.TrimPrefix(c.config.SaltPrefix, "0x"))) {
+			err = errors.New("salt does not start with salt prefix")
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(address, hit.Address) {
+		return nil, fmt.Errorf("key does not produce address %s", hit.Address)
+	}
+	lowerAddress := strings.ToLower(strings.TrimPrefix(address, "0x"))
+	result := &Result{
+		Address: address,
+		Key:     key,
+	}
+	if patternMatcher, ok := c.matcher.(PatternMatcher); ok {
+		pattern, ok := patternMatcher.MatchPattern(lowerAddress)
+		if !ok {
+			return nil, fmt.Errorf("address %s does not match any pattern", address)
+		}
+		result.Pattern = pattern
+	} else if !c.matcher.Match(lowerAddress) {
+		return nil, fmt.Errorf("address %s does not match any pattern", address)
+	}
+	return result, nil
+}
+
+func (c *Coordinator) hasResult(address string) bool {
+	for _, result := range c.results {
+		if result.Address == address {
+			return true
+		}
+	}
+	return false
+}
+
+// Runs a worker for the coordinator listening at coordinatorURL until the coordinator
+// has enough results or ctx is cancelled. Attempts are reported every options.ReportInterval
+// and hits are reported as soon as they are found. Reports which cannot be delivered are
+// retried with the next report, hits rejected by the coordinator are dropped.
+// options.OnProgress receives progress of this worker only.
+func RunWorker(ctx context.Context, coordinatorURL string, options *Options) error {
+	client := &http.Client{Timeout: 30 * time.Second}
+	baseURL := strings.TrimSuffix(coordinatorURL, "/")
+	var task taskResponse
+	if err := getJSON(ctx, client, baseURL+taskPath, &task); err != nil {
+		return err
+	}
+	if task.Done {
+		return nil
+	}
+	if task.Config.Target != SplitKeyTarget && task.Config.Target != Create2Target {
+		return fmt.Errorf("refuse to run target %s which would send private keys over network", task.Config.Target)
+	}
+	matcher, err := task.Config.Matcher()
+	if err != nil {
+		return err
+	}
+	newGenerator, err := task.Config.Generator()
+	if err != nil {
+		return err
+	}
+
+	opts := normalizeOptions(options)
+	searchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var reported uint64
+	var current uint64
+	pending := []*jsonResult{}
+	done := false
+	var reportErr error
+	flush := func(ctx context.Context) {
+		report := &reportRequest{
+			WorkerID: task.WorkerID,
+			Attempts: current - reported,
+			Results:  pending,
+		}
+		var response reportResponse
+		if err := postJSON(ctx, client, baseURL+reportPath, report, &response); err != nil {
+			reportErr = err
+			return
+		}
+		reportErr = nil
+		reported = current
+		pending = []*jsonResult{}
+		if response.Done {
+			done = true
+			cancel()
+		}
+	}
+	onProgress := opts.OnProgress
+	opts.OnProgress = func(p *Progress) {
+		if p.Attempts > current {
+			current = p.Attempts
+		}
+		flush(ctx)
+		if onProgress != nil {
+			onProgress(p)
+		}
+	}
+	filter := func(address string) (float64, string, bool) {
+		return 0, "", matcher.Match(address)
+	}
+	collect := func(result *Result) bool {
+		if result.Attempts > current {
+			current = result.Attempts
+		}
+		pending = append(pending, &jsonResult{
+			Address:  result.Address,
+			Key:      result.Key.HexStr(),
+			Attempts: result.Attempts,
+		})
+		flush(ctx)
+		return done
+	}
+	if _, err := run(searchCtx, newGenerator, opts, matcher.Difficulty(), filter, collect); err != nil {
+		return err
+	}
+	if reportErr != nil || len(pending) > 0 || current > reported {
+		// Deliver the last report even if ctx is cancelled, client timeout still applies.
+		flush(context.Background())
+	}
+	if reportErr != nil {
+		return reportErr
+	}
+	if !done {
+		return ctx.Err()
+	}
+	return nil
+}
+
+type taskResponse struct {
+	WorkerID int       `json:"workerId"`
+	Config   JobConfig `json:"config"`
+	Done     bool      `json:"done"`
+}
+
+type reportRequest struct {
+	WorkerID int           `json:"workerId"`
+	Attempts uint64        `json:"attempts"`
+	Results  []*jsonResult `json:"results"`
+}
+
+type reportResponse struct {
+	Done     bool           `json:"done"`
+	Rejected []*rejectedHit `json:"rejected,omitempty"`
+}
+
+type rejectedHit struct {
+	Address string `json:"address"`
+	Reason  string `json:"reason"`
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(value)
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, response interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	return doJSON(client, req, response)
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, request, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return doJSON(client, req, response)
+}
+
+func doJSON(client *http.Client, req *http.Request, response interface{}) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var message bytes.Buffer
+		message.ReadFrom(resp.Body)
+		return fmt.Errorf("coordinator returned %s: %s", resp.Status, strings.TrimSpace(message.String()))
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
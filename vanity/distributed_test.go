@@ -0,0 +1,176 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+func TestCoordinator_SplitKey(t *testing.T) {
+	privateKey, _ := hex.DecodeString("4646464646464646464646464646464646464646464646464646464646464646")
+	keypair := keymngr.NewSecp256k1Keypair(privateKey)
+	coordinator, err := NewCoordinator(&JobConfig{
+		Target:     SplitKeyTarget,
+		Patterns:   []string{"prefix:ab", "suffix:cd"},
+		MaxResults: 3,
+		PublicKey:  keypair.PublicKey().HexStr(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	server := httptest.NewServer(coordinator)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	workerCount := 3
+	errs := make([]error, workerCount)
+	var wg sync.WaitGroup
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = RunWorker(ctx, server.URL, &Options{Workers: 1, ReportInterval: 50 * time.Millisecond})
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("worker %d returned error %v", i, err)
+		}
+	}
+	select {
+	case <-coordinator.Done():
+	default:
+		t.Fatalf("coordinator must be done")
+	}
+	if coordinator.Workers() != workerCount {
+		t.Errorf("invalid number of workers. expected %d actual %d", workerCount, coordinator.Workers())
+	}
+	if coordinator.Progress().Attempts == 0 {
+		t.Errorf("attempts must be reported")
+	}
+	results := coordinator.Results()
+	if len(results) != 3 {
+		t.Fatalf("invalid number of results. expected 3 actual %d", len(results))
+	}
+	for _, result := range results {
+		combined, err := CombineSplitKey(privateKey, result.Key)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		account := keymngr.NewEthereumAccount(keymngr.NewSecp256k1Keypair(combined))
+		if account.AddressStr() != result.Address {
+			t.Errorf("combined key does not match address. expected %s actual %s", result.Address, account.AddressStr())
+		}
+		if result.Pattern == "" {
+			t.Errorf("result must be tagged with matched pattern")
+		}
+	}
+}
+
+func TestCoordinator_RejectForgedResult(t *testing.T) {
+	privateKey, _ := hex.DecodeString("4646464646464646464646464646464646464646464646464646464646464646")
+	keypair := keymngr.NewSecp256k1Keypair(privateKey)
+	coordinator, _ := NewCoordinator(&JobConfig{
+		Target:     SplitKeyTarget,
+		Patterns:   []string{"prefix:a"},
+		MaxResults: 10,
+		PublicKey:  keypair.PublicKey().HexStr(),
+	})
+	server := httptest.NewServer(coordinator)
+	defer server.Close()
+	var valid string
+	for offset := byte(1); valid == ""; offset++ {
+		address, _ := SplitKeyAddress(keypair.PublicKey(), []byte{offset})
+		if strings.HasPrefix(strings.ToLower(address), "0xa") {
+			valid = fmt.Sprintf(`{"address":"%s","key":"%02x"}`, address, offset)
+		}
+	}
+	forged := `{"address":"0xab00000000000000000000000000000000000000","key":"01"}`
+
+	tests := []struct {
+		name     string
+		body     string
+		status   int
+		accepted int
+		rejected int
+	}{
+		{"wrong_address", `{"attempts":5,"results":[` + forged + `]}`, http.StatusOK, 0, 1},
+		{"invalid_key", `{"attempts":5,"results":[{"address":"0xab00000000000000000000000000000000000000","key":"zz"}]}`, http.StatusOK, 0, 1},
+		{"mixed", `{"attempts":5,"results":[` + forged + `,` + valid + `]}`, http.StatusOK, 1, 1},
+		{"malformed", `{"results":`, http.StatusBadRequest, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := coordinator.Progress()
+			resp, err := http.Post(server.URL+reportPath, "application/json", bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("invalid status. expected %d actual %d", tt.status, resp.StatusCode)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var response reportResponse
+			json.NewDecoder(resp.Body).Decode(&response)
+			if len(response.Rejected) != tt.rejected {
+				t.Errorf("invalid rejected hits. expected %d actual %d", tt.rejected, len(response.Rejected))
+			}
+			after := coordinator.Progress()
+			if after.Found-before.Found != tt.accepted || after.Attempts-before.Attempts != 5 {
+				t.Errorf("valid hits and attempts must be credited. actual %d hits %d attempts",
+					after.Found-before.Found, after.Attempts-before.Attempts)
+			}
+		})
+	}
+	for _, result := range coordinator.Results() {
+		if strings.HasPrefix(strings.ToLower(result.Address), "0xab000000") {
+			t.Errorf("forged results must not be accepted")
+		}
+	}
+}
+
+func TestNewCoordinator_RawKeyTarget(t *testing.T) {
+	_, err := NewCoordinator(&JobConfig{Target: EthereumTarget, Patterns: []string{"prefix:ab"}})
+	if err == nil || !strings.Contains(err.Error(), SplitKeyTarget) {
+		t.Errorf("expected error suggesting split-key target. actual %v", err)
+	}
+}
+
+func TestCombineSplitKey(t *testing.T) {
+	privateKey, _ := hex.DecodeString("4646464646464646464646464646464646464646464646464646464646464646")
+	offset, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000005")
+	combined, err := CombineSplitKey(privateKey, offset)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	publicKey := keymngr.NewSecp256k1Keypair(privateKey).UncompressPublicKey()
+	address, err := SplitKeyAddress(publicKey, offset)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := keymngr.NewEthereumAccount(keymngr.NewSecp256k1Keypair(combined)).AddressStr()
+	if address != expected {
+		t.Errorf("invalid split-key address. expected %s actual %s", expected, address)
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"context"
+	"errors"
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lukaz17/cryptotool-go/audit"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	// Number of attempts a worker performs before checking for cancellation and updating counters.
+	workerBatchSize = 256
+)
+
+// A Generator produces consecutive candidates for a single worker.
+// Generator is not required to be safe for concurrent use, each worker owns its own Generator.
+type Generator interface {
+	// Advances to the next candidate and returns its address in the format expected by Matcher.
+	Next() string
+	// Returns the Result describing the current candidate.
+	Result() *Result
+}
+
+// A GeneratorFactory returns a new independent Generator for a worker.
+type GeneratorFactory func() (Generator, error)
+
+// A privateKeyGenerator is a Generator whose Result.Key is a private key.
+// Its results are audited and weak keys are never emitted, see package audit.
+type privateKeyGenerator interface {
+	Generator
+	generatesPrivateKeys()
+}
+
+// A failingGenerator is a Generator which may stop because of an error, e.g. a failure of
+// crypto/rand. Once Err returns an error, the search is stopped and returns that error.
+type failingGenerator interface {
+	Generator
+	Err() error
+}
+
+// A Result contains information of a matched candidate.
+type Result struct {
+	// Address in display format, e.g. EIP-55 checksum address for Ethereum.
+	Address string
+	// Private key for account searches or salt for CREATE2 searches.
+	Key stdx.Bytes
+	// Total attempts of all workers when the candidate was found.
+	Attempts uint64
+	// Score of the address for searches using a Scorer.
+	Score float64
+	// True if Score is set by a Scorer, a score of 0 is still a valid score.
+	IsScored bool
+	// Pattern satisfied by the address for searches using a PatternMatcher.
+	Pattern string
+	// Mnemonic and derivation path of the key for mnemonic searches.
+	Mnemonic       string
+	DerivationPath string
+}
+
+// Options contains settings of a search.
+type Options struct {
+	// Number of concurrent workers. Default to number of CPU cores.
+	Workers int
+	// Number of results to find before stopping. Default to 1.
+	MaxResults int
+	// Number of best results kept by SearchBest. Default to 10.
+	Keep int
+	// Interval between two progress reports. Default to 1 second.
+	ReportInterval time.Duration
+	// Function to receive progress reports. Progress is not reported if nil.
+	OnProgress func(*Progress)
+}
+
+// Progress contains statistics of a running search.
+type Progress struct {
+	// Total attempts of all workers.
+	Attempts uint64
+	// Time since the search started.
+	Elapsed time.Duration
+	// Expected number of attempts to find one result. Zero if unknown.
+	Difficulty float64
+	// Number of results found so far. For SearchBest, this is the number of improvements.
+	Found int
+}
+
+// Returns the number of attempts per second.
+func (p *Progress) Rate() float64 {
+	if p.Elapsed <= 0 {
+		return 0
+	}
+	return float64(p.Attempts) / p.Elapsed.Seconds()
+}
+
+// Returns the probability that at least one result would have been found after current attempts.
+func (p *Progress) Probability() float64 {
+	return Probability(p.Difficulty, p.Attempts)
+}
+
+// Returns the expected time to find one result at current rate.
+// Returns -1 if difficulty or rate is unknown.
+func (p *Progress) ExpectedTime() time.Duration {
+	rate := p.Rate()
+	if p.Difficulty <= 0 || rate <= 0 {
+		return -1
+	}
+	return durationFromSeconds(p.Difficulty / rate)
+}
+
+// Returns the time needed to reach probability (0, 1) at current rate, counted from the start of the search.
+// Returns -1 if difficulty or rate is unknown.
+func (p *Progress) TimeToProbability(probability float64) time.Duration {
+	rate := p.Rate()
+	if p.Difficulty <= 0 || rate <= 0 || probability <= 0 || probability >= 1 {
+		return -1
+	}
+	return durationFromSeconds(AttemptsForProbability(p.Difficulty, probability) / rate)
+}
+
+// Returns the probability that at least one match is found within attempts
+// when each attempt matches with probability 1/difficulty.
+func Probability(difficulty float64, attempts uint64) float64 {
+	if difficulty <= 1 {
+		return 1
+	}
+	return -math.Expm1(float64(attempts) * math.Log1p(-1/difficulty))
+}
+
+// Returns the number of attempts needed to find at least one match with the given probability.
+func AttemptsForProbability(difficulty, probability float64) float64 {
+	if difficulty <= 1 {
+		return 1
+	}
+	return math.Log1p(-probability) / math.Log1p(-1/difficulty)
+}
+
+// Runs a search using all workers until enough results are found or ctx is cancelled.
+// Results found before cancellation are returned along with the context error.
+func Search(ctx context.Context, newGenerator GeneratorFactory, matcher Matcher, options *Options) ([]*Result, error) {
+	if newGenerator == nil || matcher == nil {
+		return nil, errors.New("generator and matcher are required")
+	}
+	opts := normalizeOptions(options)
+	results := []*Result{}
+	filter := func(address string) (float64, string, bool) {
+		return 0, "", matcher.Match(address)
+	}
+	if patternMatcher, ok := matcher.(PatternMatcher); ok {
+		filter = func(address string) (float64, string, bool) {
+			pattern, ok := patternMatcher.MatchPattern(address)
+			return 0, pattern, ok
+		}
+	}
+	collect := func(result *Result) bool {
+		results = append(results, result)
+		return len(results) >= opts.MaxResults
+	}
+	done, err := run(ctx, newGenerator, opts, matcher.Difficulty(), filter, collect)
+	if err != nil {
+		return nil, err
+	}
+	if !done {
+		return results, ctx.Err()
+	}
+	return results, nil
+}
+
+// A candidateFilter returns the score, the satisfied pattern of an address and whether it should be reported.
+type candidateFilter func(address string) (float64, string, bool)
+
+// Starts workers and passes accepted candidates to collect until collect returns true or ctx is cancelled.
+// Returns true if the search is stopped by collect.
+func run(ctx context.Context, newGenerator GeneratorFactory, opts *Options, difficulty float64,
+	filter candidateFilter, collect func(*Result) bool) (bool, error) {
+	generators := make([]Generator, opts.Workers)
+	for i := range generators {
+		generator, err := newGenerator()
+		if err != nil {
+			return false, err
+		}
+		generators[i] = generator
+	}
+
+	searchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var attempts uint64
+	found := make(chan *Result)
+	var wg sync.WaitGroup
+	var workerErr error
+	var errOnce sync.Once
+	for _, generator := range generators {
+		wg.Add(1)
+		go func(generator Generator) {
+			defer wg.Done()
+			if err := runWorker(searchCtx, generator, filter, &attempts, found); err != nil {
+				errOnce.Do(func() {
+					workerErr = err
+					cancel()
+				})
+			}
+		}(generator)
+	}
+	go func() {
+		wg.Wait()
+		close(found)
+	}()
+
+	start := time.Now()
+	ticker := time.NewTicker(opts.ReportInterval)
+	defer ticker.Stop()
+	count := 0
+	report := func() {
+		if opts.OnProgress == nil {
+			return
+		}
+		opts.OnProgress(&Progress{
+			Attempts:   atomic.LoadUint64(&attempts),
+			Elapsed:    time.Since(start),
+			Difficulty: difficulty,
+			Found:      count,
+		})
+	}
+	for {
+		select {
+		case result, ok := <-found:
+			if !ok {
+				report()
+				return false, workerErr
+			}
+			count++
+			if collect(result) {
+				cancel()
+				report()
+				return true, nil
+			}
+		case <-ticker.C:
+			report()
+		}
+	}
+}
+
+// Returns the error of generator if it fails, nil if ctx is cancelled.
+func runWorker(ctx context.Context, generator Generator, filter candidateFilter, attempts *uint64, found chan<- *Result) error {
+	_, auditKeys := generator.(privateKeyGenerator)
+	failing, canFail := generator.(failingGenerator)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		for i := 0; i < workerBatchSize; i++ {
+			address := generator.Next()
+			if canFail {
+				if err := failing.Err(); err != nil {
+					return err
+				}
+			}
+			score, pattern, ok := filter(address)
+			if !ok {
+				continue
+			}
+			result := generator.Result()
+			if auditKeys && audit.AuditPrivateKey(result.Key).IsWeak() {
+				continue
+			}
+			result.Score = score
+			result.Pattern = pattern
+			result.Attempts = atomic.LoadUint64(attempts) + uint64(i+1)
+			select {
+			case found <- result:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+		atomic.AddUint64(attempts, workerBatchSize)
+	}
+}
+
+func normalizeOptions(options *Options) *Options {
+	opts := &Options{}
+	if options != nil {
+		*opts = *options
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+	if opts.MaxResults <= 0 {
+		opts.MaxResults = 1
+	}
+	if opts.Keep <= 0 {
+		opts.Keep = 10
+	}
+	if opts.ReportInterval <= 0 {
+		opts.ReportInterval = time.Second
+	}
+	return opts
+}
+
+func durationFromSeconds(seconds float64) time.Duration {
+	if seconds > float64(math.MaxInt64)/float64(time.Second) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,216 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+func TestSearch_Ethereum(t *testing.T) {
+	matcher, _ := NewPrefixSuffixMatcher("a", "b")
+	progressCount := 0
+	results, err := Search(context.Background(), NewEthereumGenerator(), matcher, &Options{
+		Workers:    2,
+		MaxResults: 3,
+		OnProgress: func(p *Progress) { progressCount++ },
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("invalid number of results. expected 3 actual %d", len(results))
+	}
+	if progressCount == 0 {
+		t.Errorf("progress must be reported when search finishes")
+	}
+	for _, result := range results {
+		account := keymngr.NewEthereumAccount(keymngr.NewSecp256k1Keypair(result.Key))
+		if account.AddressStr() != result.Address {
+			t.Errorf("private key does not match address. expected %s actual %s", result.Address, account.AddressStr())
+		}
+		lowerAddress := strings.ToLower(result.Address)
+		if !strings.HasPrefix(lowerAddress, "0xa") || !strings.HasSuffix(lowerAddress, "b") {
+			t.Errorf("address does not match pattern %s", result.Address)
+		}
+	}
+}
+
+func TestSearch_Create2(t *testing.T) {
+	deployer, _ := hex.DecodeString("0000000000ffe8b47b3e2130213b802212439497")
+	initCodeHash, _ := hex.DecodeString("21c35dbe1b344a2488cf3321d6ce542f8e9f305544ff09e4993a62319a497c1f")
+	caller, _ := hex.DecodeString("114a781017506df34b3ed4c0e6b438889a6eb3f7")
+	factory, err := NewCreate2Generator(deployer, initCodeHash, caller)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	matcher, _ := NewLeadingZeroBytesMatcher(1)
+	results, err := Search(context.Background(), factory, matcher, &Options{Workers: 2})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	result := results[0]
+	if !bytes.HasPrefix(result.Key, caller) {
+		t.Errorf("salt must start with caller address. actual %s", result.Key.HexStr())
+	}
+	address, _ := keymngr.Create2AddressStr(hex.EncodeToString(deployer), result.Key.HexStr(), hex.EncodeToString(initCodeHash))
+	if address != result.Address {
+		t.Errorf("salt does not match address. expected %s actual %s", result.Address, address)
+	}
+	if !strings.HasPrefix(address, "0x00") {
+		t.Errorf("address does not have leading zero byte %s", address)
+	}
+}
+
+func TestSearch_Cancel(t *testing.T) {
+	matcher, _ := NewLeadingZeroBytesMatcher(20)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err := Search(ctx, NewEthereumGenerator(), matcher, &Options{Workers: 1})
+	if err == nil {
+		t.Errorf("expected context error")
+	}
+	if len(results) != 0 {
+		t.Errorf("expected no results. actual %d", len(results))
+	}
+}
+
+func TestProgress(t *testing.T) {
+	progress := &Progress{Attempts: 1000000, Elapsed: 1e9, Difficulty: 1 << 20}
+	if progress.Rate() != 1000000 {
+		t.Errorf("invalid rate. expected 1000000 actual %v", progress.Rate())
+	}
+	if progress.ExpectedTime().Seconds() < 1.04 || progress.ExpectedTime().Seconds() > 1.05 {
+		t.Errorf("invalid expected time %v", progress.ExpectedTime())
+	}
+	probability := progress.Probability()
+	if probability < 0.61 || probability > 0.62 {
+		t.Errorf("invalid probability %v", probability)
+	}
+	half := progress.TimeToProbability(0.5).Seconds()
+	if half < 0.72 || half > 0.73 {
+		t.Errorf("invalid time to 50%% probability %v", half)
+	}
+}
+
+// weakGenerator walks small private keys like a generator with broken seeding.
+type weakGenerator struct {
+	key uint64
+}
+
+func (g *weakGenerator) Next() string {
+	g.key++
+	return "ab00000000000000000000000000000000000000"
+}
+
+func (g *weakGenerator) Result() *Result {
+	key := make([]byte, 32)
+	binary.BigEndian.PutUint64(key[24:], g.key)
+	return &Result{Address: "0xab00000000000000000000000000000000000000", Key: key}
+}
+
+func (g *weakGenerator) generatesPrivateKeys() {}
+
+func TestSearch_RefuseWeakKeys(t *testing.T) {
+	factory := func() (Generator, error) {
+		return &weakGenerator{}, nil
+	}
+	matcher, _ := NewPrefixSuffixMatcher("ab", "")
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	results, err := Search(ctx, factory, matcher, &Options{Workers: 1})
+	if err == nil || len(results) != 0 {
+		t.Errorf("weak keys must not be emitted. actual %d results", len(results))
+	}
+}
+
+// brokenGenerator stops after limit candidates like a generator whose entropy source fails.
+type brokenGenerator struct {
+	count int
+	limit int
+	err   error
+}
+
+func (g *brokenGenerator) Next() string {
+	g.count++
+	if g.count > g.limit {
+		g.err = errors.New("entropy source failed")
+	}
+	return "cd00000000000000000000000000000000000000"
+}
+
+func (g *brokenGenerator) Result() *Result {
+	return &Result{Address: "0xcd00000000000000000000000000000000000000"}
+}
+
+func (g *brokenGenerator) Err() error {
+	return g.err
+}
+
+func TestSearch_GeneratorError(t *testing.T) {
+	tests := []struct {
+		name    string
+		workers int
+		limit   int
+	}{
+		{"first_candidate", 1, 0},
+		{"later_candidate", 4, 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := func() (Generator, error) {
+				return &brokenGenerator{limit: tt.limit}, nil
+			}
+			matcher, _ := NewPrefixSuffixMatcher("ab", "")
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			results, err := Search(ctx, factory, matcher, &Options{Workers: tt.workers})
+			if err == nil || err.Error() != "entropy source failed" || results != nil {
+				t.Errorf("expected generator error. actual %v", err)
+			}
+		})
+	}
+}
+
+func TestGenerators_JumpAfterResult(t *testing.T) {
+	bitcoin, _ := NewBitcoinGenerator(keymngr.P2WPKH, keymngr.BitcoinMainnet)
+	tests := []struct {
+		name    string
+		factory GeneratorFactory
+	}{
+		{"ethereum", NewEthereumGenerator()},
+		{"bitcoin", bitcoin},
+		{"tron", NewTronGenerator()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, err := tt.factory()
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			generator.Next()
+			previous := new(big.Int).SetBytes(generator.Result().Key)
+			for i := 0; i < 8; i++ {
+				generator.Next()
+				current := new(big.Int).SetBytes(generator.Result().Key)
+				if distance := new(big.Int).Sub(current, previous); distance.CmpAbs(big.NewInt(1<<32)) < 0 {
+					t.Fatalf("consecutive results are %v steps apart", distance)
+				}
+				previous = current
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+
+	btcutil "github.com/FactomProject/btcutilecc"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+	"github.com/tforce-io/tf-golib/stdx"
+	"golang.org/x/crypto/sha3"
+)
+
+// secp256k1Walk walks consecutive private keys from a random starting point.
+// Moving from k to k+1 only requires a point addition which is much cheaper than
+// a scalar multiplication for every candidate. The walk jumps to a new random key
+// once a key is emitted, so results are never a few steps apart on the curve.
+type secp256k1Walk struct {
+	privateKey *big.Int
+	x          *big.Int
+	y          *big.Int
+	jump       bool
+	err        error
+}
+
+// Moves the walk to a random private key read from crypto/rand.
+func (w *secp256k1Walk) seed() error {
+	privateKey, err := randomScalar()
+	if err != nil {
+		return err
+	}
+	if w.privateKey != nil {
+		w.privateKey.SetInt64(0)
+	}
+	// Step back once so the next call to next returns the random key.
+	w.privateKey = privateKey.Sub(privateKey, big.NewInt(1))
+	w.x, w.y = btcutil.Secp256k1().ScalarBaseMult(w.privateKey.Bytes())
+	return nil
+}
+
+// Advances to the next private key. Returns false if crypto/rand fails, see Err.
+func (w *secp256k1Walk) next() bool {
+	if w.err != nil {
+		return false
+	}
+	if w.jump {
+		w.jump = false
+		if w.err = w.seed(); w.err != nil {
+			return false
+		}
+	}
+	curve := btcutil.Secp256k1()
+	params := curve.Params()
+	w.privateKey.Add(w.privateKey, big.NewInt(1))
+	if w.privateKey.Cmp(params.N) >= 0 {
+		w.privateKey.SetInt64(1)
+		w.x, w.y = new(big.Int).Set(params.Gx), new(big.Int).Set(params.Gy)
+	} else {
+		w.x, w.y = curve.Add(w.x, w.y, params.Gx, params.Gy)
+	}
+	return true
+}
+
+// Returns a copy of the current private key and makes the walk jump before the next key.
+func (w *secp256k1Walk) emit() stdx.Bytes {
+	privateKey := make([]byte, 32)
+	w.privateKey.FillBytes(privateKey)
+	w.jump = true
+	return stdx.Bytes(privateKey)
+}
+
+// Returns the error of crypto/rand which stopped the walk.
+func (w *secp256k1Walk) Err() error {
+	return w.err
+}
+
+// ethereumGenerator derives Ethereum addresses from a secp256k1Walk.
+type ethereumGenerator struct {
+	secp256k1Walk
+	address []byte
+	pubkey  []byte
+}
+
+// Returns a GeneratorFactory for Ethereum accounts. Each worker starts from a random private key
+// read from crypto/rand and jumps to a new one after every result.
+func NewEthereumGenerator() GeneratorFactory {
+	return func() (Generator, error) {
+		return newEthereumGenerator()
+	}
+}
+
+// Returns an ethereumGenerator starting from a random private key.
+func newEthereumGenerator() (*ethereumGenerator, error) {
+	g := &ethereumGenerator{
+		pubkey: make([]byte, 64),
+	}
+	if err := g.seed(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (g *ethereumGenerator) Next() string {
+	if !g.next() {
+		return ""
+	}
+	g.address = ethereumAddress(g.x, g.y, g.pubkey)
+	return hex.EncodeToString(g.address)
+}
+
+func (g *ethereumGenerator) Result() *Result {
+	privateKey := g.emit()
+	account := keymngr.NewEthereumAccount(keymngr.NewSecp256k1Keypair(privateKey))
+	defer account.Destroy()
+	return &Result{
+		Address: account.AddressStr(),
+		Key:     privateKey,
+	}
+}
+
+func (g *ethereumGenerator) generatesPrivateKeys() {}
+
+// Returns the Ethereum address of public key (x, y) using buffer of 64 bytes as scratch space.
+func ethereumAddress(x, y *big.Int, buffer []byte) []byte {
+	x.FillBytes(buffer[:32])
+	y.FillBytes(buffer[32:])
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(buffer)
+	hash := hasher.Sum(nil)
+	return hash[12:]
+}
+
+// Returns a uniformly random scalar in range [1, n-1] of Secp256k1.
+func randomScalar() (*big.Int, error) {
+	n := btcutil.Secp256k1().Params().N
+	max := new(big.Int).Sub(n, big.NewInt(1))
+	k, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return nil, err
+	}
+	return k.Add(k, big.NewInt(1)), nil
+}
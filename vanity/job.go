@@ -0,0 +1,372 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lukaz17/cryptotool-go/encryptor"
+	"github.com/lukaz17/cryptotool-go/internal/fileutil"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	EthereumTarget = "ethereum"
+	SplitKeyTarget = "split-key"
+	Create2Target  = "create2"
+
+	jobStateVersion = 1
+	// Known plaintext encrypted in the state file to verify the password on resume.
+	jobVerifier = "cryptotool-vanity-job"
+)
+
+// JobConfig contains everything needed to rebuild a search after restart.
+// Patterns use format "kind:value". Kinds prefix, suffix and contains can be combined
+// and are matched by MultiMatcher. Kinds wildcard, regex and zeros (leading zero bytes)
+// must be used alone.
+type JobConfig struct {
+	Target       string   `json:"target"`
+	Patterns     []string `json:"patterns"`
+	Checksum     bool     `json:"checksum,omitempty"`
+	MaxResults   int      `json:"maxResults"`
+	PublicKey    string   `json:"publicKey,omitempty"`
+	Deployer     string   `json:"deployer,omitempty"`
+	InitCodeHash string   `json:"initCodeHash,omitempty"`
+	SaltPrefix   string   `json:"saltPrefix,omitempty"`
+}
+
+// Returns the Matcher described by Patterns.
+func (c *JobConfig) Matcher() (Matcher, error) {
+	if len(c.Patterns) == 0 {
+		return nil, errors.New("at least one pattern is required")
+	}
+	var patterns []Pattern
+	for _, str := range c.Patterns {
+		kind, value := "prefix", str
+		if i := strings.Index(str, ":"); i >= 0 {
+			kind, value = strings.ToLower(str[:i]), str[i+1:]
+		}
+		switch kind {
+		case "wildcard", "regex", "zeros":
+			if len(c.Patterns) != 1 {
+				return nil, fmt.Errorf("%s pattern must be used alone", kind)
+			}
+		}
+		switch kind {
+		case "wildcard":
+			return NewWildcardMatcher(value, c.Checksum)
+		case "regex":
+			return NewRegexMatcher(value, c.Checksum)
+		case "zeros":
+			count, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number of zero bytes %s", value)
+			}
+			return NewLeadingZeroBytesMatcher(count)
+		}
+		pattern, err := ParsePattern(str)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, pattern)
+	}
+	return NewMultiMatcher(patterns)
+}
+
+// Returns the GeneratorFactory described by Target.
+func (c *JobConfig) Generator() (GeneratorFactory, error) {
+	switch c.Target {
+	case EthereumTarget:
+		return NewEthereumGenerator(), nil
+	case SplitKeyTarget:
+		publicKey, err := decodeHex(c.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %v", err)
+		}
+		return NewSplitKeyGenerator(publicKey)
+	case Create2Target:
+		deployer, err := decodeHex(c.Deployer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid deployer: %v", err)
+		}
+		initCodeHash, err := decodeHex(c.InitCodeHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid init code hash: %v", err)
+		}
+		saltPrefix, err := decodeHex(c.SaltPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid salt prefix: %v", err)
+		}
+		return NewCreate2Generator(deployer, initCodeHash, saltPrefix)
+	}
+	return nil, fmt.Errorf("unknown target %s", c.Target)
+}
+
+// A JobResult is a Result persisted in the state file with its key encrypted.
+type JobResult struct {
+	Address      string                   `json:"address"`
+	Pattern      string                   `json:"pattern,omitempty"`
+	Attempts     uint64                   `json:"attempts"`
+	FoundAt      time.Time                `json:"foundAt"`
+	EncryptedKey *encryptor.EncryptedData `json:"encryptedKey"`
+}
+
+// jobState is the content of a state file.
+type jobState struct {
+	Version    int                      `json:"version"`
+	Config     JobConfig                `json:"config"`
+	Attempts   uint64                   `json:"attempts"`
+	Elapsed    time.Duration            `json:"elapsed"`
+	Difficulty float64                  `json:"difficulty"`
+	Verifier   *encryptor.EncryptedData `json:"verifier"`
+	Results    []*JobResult             `json:"results"`
+	UpdatedAt  time.Time                `json:"updatedAt"`
+}
+
+// A Job is a long running search which persists its progress to a state file,
+// so it can be resumed after restart. Keys of found results are encrypted with the
+// password supplied when the job was created and are never written in plaintext.
+type Job struct {
+	// Minimum interval between two periodic checkpoints. Default to 1 minute.
+	// A checkpoint is always written when a result is found and when Run returns.
+	CheckpointInterval time.Duration
+
+	path     string
+	password []byte
+	state    *jobState
+	mu       sync.Mutex
+}
+
+// Returns a new Job and writes its initial state to path. Fails if path already exists.
+func NewJob(path string, config *JobConfig, password []byte) (*Job, error) {
+	if len(password) == 0 {
+		return nil, errors.New("password is required")
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("state file %s already exists", path)
+	}
+	matcher, err := config.Matcher()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := config.Generator(); err != nil {
+		return nil, err
+	}
+	verifier, err := encryptor.Encrypt([]byte(jobVerifier), password)
+	if err != nil {
+		return nil, err
+	}
+	job := &Job{
+		path:     path,
+		password: append([]byte{}, password...),
+		state: &jobState{
+			Version:    jobStateVersion,
+			Config:     *config,
+			Difficulty: matcher.Difficulty(),
+			Verifier:   verifier,
+			Results:    []*JobResult{},
+		},
+	}
+	if job.state.Config.MaxResults <= 0 {
+		job.state.Config.MaxResults = 1
+	}
+	if err := job.checkpoint(); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Returns a Job loaded from the state file at path. The password is verified before returning.
+func OpenJob(path string, password []byte) (*Job, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	state := &jobState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("invalid state file: %v", err)
+	}
+	if state.Version != jobStateVersion {
+		return nil, fmt.Errorf("unsupported state file version %d", state.Version)
+	}
+	plaintext, err := encryptor.Decrypt(state.Verifier, password)
+	if err != nil || string(plaintext) != jobVerifier {
+		return nil, encryptor.ErrDecryption
+	}
+	return &Job{
+		path:     path,
+		password: append([]byte{}, password...),
+		state:    state,
+	}, nil
+}
+
+// Returns the configuration of the job.
+func (j *Job) Config() JobConfig {
+	return j.state.Config
+}
+
+// Returns true if the job has found enough results.
+func (j *Job) IsDone() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return len(j.state.Results) >= j.state.Config.MaxResults
+}
+
+// Returns cumulative progress of all runs.
+// Since every attempt is independent, previous attempts do not shorten the expected time
+// to the next result, they only increase the probability that a result would have been found.
+func (j *Job) Progress() *Progress {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return &Progress{
+		Attempts:   j.state.Attempts,
+		Elapsed:    j.state.Elapsed,
+		Difficulty: j.state.Difficulty,
+		Found:      len(j.state.Results),
+	}
+}
+
+// Returns found results with decrypted keys.
+func (j *Job) Results() ([]*Result, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	results := make([]*Result, 0, len(j.state.Results))
+	for _, jr := range j.state.Results {
+		key, err := encryptor.Decrypt(jr.EncryptedKey, j.password)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &Result{
+			Address:  jr.Address,
+			Key:      stdx.Bytes(key),
+			Attempts: jr.Attempts,
+			Pattern:  jr.Pattern,
+		})
+	}
+	return results, nil
+}
+
+// Runs the job until it has enough results or ctx is cancelled. Progress reported to
+// options.OnProgress is cumulative across runs. The state file is updated periodically,
+// whenever a result is found and before Run returns.
+func (j *Job) Run(ctx context.Context, options *Options) error {
+	if j.IsDone() {
+		return nil
+	}
+	matcher, err := j.state.Config.Matcher()
+	if err != nil {
+		return err
+	}
+	newGenerator, err := j.state.Config.Generator()
+	if err != nil {
+		return err
+	}
+	opts := normalizeOptions(options)
+	checkpointInterval := j.CheckpointInterval
+	if checkpointInterval <= 0 {
+		checkpointInterval = time.Minute
+	}
+
+	j.mu.Lock()
+	baseAttempts, baseElapsed := j.state.Attempts, j.state.Elapsed
+	j.mu.Unlock()
+	lastCheckpoint := time.Now()
+	var checkpointErr error
+	onProgress := opts.OnProgress
+	opts.OnProgress = func(p *Progress) {
+		j.mu.Lock()
+		if baseAttempts+p.Attempts > j.state.Attempts {
+			j.state.Attempts = baseAttempts + p.Attempts
+		}
+		j.state.Elapsed = baseElapsed + p.Elapsed
+		j.mu.Unlock()
+		if time.Since(lastCheckpoint) >= checkpointInterval {
+			lastCheckpoint = time.Now()
+			if err := j.checkpoint(); err != nil && checkpointErr == nil {
+				checkpointErr = err
+			}
+		}
+		if onProgress != nil {
+			onProgress(j.Progress())
+		}
+	}
+	filter := func(address string) (float64, string, bool) {
+		return 0, "", matcher.Match(address)
+	}
+	if patternMatcher, ok := matcher.(PatternMatcher); ok {
+		filter = func(address string) (float64, string, bool) {
+			pattern, ok := patternMatcher.MatchPattern(address)
+			return 0, pattern, ok
+		}
+	}
+	collect := func(result *Result) bool {
+		encryptedKey, err := encryptor.Encrypt(result.Key, j.password)
+		if err != nil {
+			if checkpointErr == nil {
+				checkpointErr = err
+			}
+			return true
+		}
+		j.mu.Lock()
+		// Workers count attempts in batches, so a result may be ahead of the last report.
+		if baseAttempts+result.Attempts > j.state.Attempts {
+			j.state.Attempts = baseAttempts + result.Attempts
+		}
+		j.state.Results = append(j.state.Results, &JobResult{
+			Address:      result.Address,
+			Pattern:      result.Pattern,
+			Attempts:     baseAttempts + result.Attempts,
+			FoundAt:      time.Now().UTC(),
+			EncryptedKey: encryptedKey,
+		})
+		done := len(j.state.Results) >= j.state.Config.MaxResults
+		j.mu.Unlock()
+		if err := j.checkpoint(); err != nil && checkpointErr == nil {
+			checkpointErr = err
+		}
+		return done
+	}
+	_, err = run(ctx, newGenerator, opts, matcher.Difficulty(), filter, collect)
+	if err != nil {
+		return err
+	}
+	if err := j.checkpoint(); err != nil {
+		return err
+	}
+	if checkpointErr != nil {
+		return checkpointErr
+	}
+	if !j.IsDone() {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// Writes the state atomically by replacing the state file with a fully written temporary file.
+func (j *Job) checkpoint() error {
+	j.mu.Lock()
+	j.state.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(j.state, "", "  ")
+	j.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteFileAtomic(j.path, data)
+}
+
+func decodeHex(str string) (stdx.Bytes, error) {
+	return hex.DecodeString(strings.TrimPrefix(str, "0x"))
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+func TestJob_RunAndOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "job.json")
+	password := []byte("correct horse")
+	config := &JobConfig{
+		Target:     EthereumTarget,
+		Patterns:   []string{"prefix:a", "suffix:b"},
+		MaxResults: 2,
+	}
+	job, err := NewJob(path, config, password)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := NewJob(path, config, password); err == nil {
+		t.Errorf("expected error when state file exists")
+	}
+	if err := job.Run(context.Background(), &Options{Workers: 2}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !job.IsDone() {
+		t.Fatalf("job must be done")
+	}
+
+	if _, err := OpenJob(path, []byte("wrong")); err == nil {
+		t.Errorf("expected error for wrong password")
+	}
+	reopened, err := OpenJob(path, password)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if reopened.Progress().Attempts == 0 || reopened.Progress().Attempts != job.Progress().Attempts {
+		t.Errorf("attempts are not persisted. expected %d actual %d", job.Progress().Attempts, reopened.Progress().Attempts)
+	}
+	results, err := reopened.Results()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("invalid number of results. expected 2 actual %d", len(results))
+	}
+	state, _ := os.ReadFile(path)
+	for _, result := range results {
+		account := keymngr.NewEthereumAccount(keymngr.NewSecp256k1Keypair(result.Key))
+		if account.AddressStr() != result.Address {
+			t.Errorf("private key does not match address. expected %s actual %s", result.Address, account.AddressStr())
+		}
+		if strings.Contains(string(state), result.Key.HexStr()) {
+			t.Errorf("private key is written to state file in plaintext")
+		}
+	}
+}
+
+func TestJob_Resume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "job.json")
+	password := []byte("correct horse")
+	job, err := NewJob(path, &JobConfig{Target: EthereumTarget, Patterns: []string{"zeros:20"}}, password)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := job.Run(ctx, &Options{Workers: 1}); err == nil {
+		t.Errorf("expected context error")
+	}
+	first := job.Progress()
+	if first.Attempts == 0 || first.Elapsed == 0 {
+		t.Fatalf("progress is not recorded %+v", first)
+	}
+
+	reopened, err := OpenJob(path, password)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	var reported *Progress
+	reopened.Run(ctx, &Options{Workers: 1, OnProgress: func(p *Progress) { reported = p }})
+	second := reopened.Progress()
+	if second.Attempts <= first.Attempts || second.Elapsed <= first.Elapsed {
+		t.Errorf("progress must accumulate across runs. first %+v second %+v", first, second)
+	}
+	if reported == nil || reported.Attempts != second.Attempts {
+		t.Errorf("reported progress must be cumulative %+v", reported)
+	}
+}
+
+func TestJobConfig_Matcher_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+	}{
+		{"empty", nil},
+		{"regex combined", []string{"regex:^ab", "prefix:cd"}},
+		{"invalid zeros", []string{"zeros:x"}},
+		{"invalid kind", []string{"unknown:ab"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &JobConfig{Target: EthereumTarget, Patterns: tt.patterns}
+			if _, err := config.Matcher(); err == nil {
+				t.Errorf("expected error for %v", tt.patterns)
+			}
+		})
+	}
+}
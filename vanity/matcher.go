@@ -0,0 +1,85 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// A Matcher decides whether a candidate address is acceptable.
+// For Ethereum, addresses are passed to Matcher in lowercase hex without 0x prefix.
+// Matcher must be safe for concurrent use.
+type Matcher interface {
+	// Returns true if the address is acceptable.
+	Match(address string) bool
+	// Returns expected number of attempts to find one match. Zero if unknown.
+	Difficulty() float64
+}
+
+// A PrefixSuffixMatcher matches hex addresses starting with prefix and ending with suffix.
+type PrefixSuffixMatcher struct {
+	prefix string
+	suffix string
+}
+
+// Returns a PrefixSuffixMatcher for hex addresses. Matching is case-insensitive and 0x prefix is ignored.
+func NewPrefixSuffixMatcher(prefix, suffix string) (*PrefixSuffixMatcher, error) {
+	prefix = strings.ToLower(strings.TrimPrefix(prefix, "0x"))
+	suffix = strings.ToLower(suffix)
+	if prefix == "" && suffix == "" {
+		return nil, errors.New("prefix or suffix is required")
+	}
+	isValid, _ := regexp.MatchString(`^[0-9a-f]*$`, prefix+suffix)
+	if !isValid {
+		return nil, errors.New("prefix and suffix must be hex characters")
+	}
+	if len(prefix)+len(suffix) > 40 {
+		return nil, errors.New("prefix and suffix are longer than address")
+	}
+	return &PrefixSuffixMatcher{
+		prefix: prefix,
+		suffix: suffix,
+	}, nil
+}
+
+// Returns true if address starts with prefix and ends with suffix.
+func (m *PrefixSuffixMatcher) Match(address string) bool {
+	return strings.HasPrefix(address, m.prefix) && strings.HasSuffix(address, m.suffix)
+}
+
+// Returns 16 to the power of number of fixed characters.
+func (m *PrefixSuffixMatcher) Difficulty() float64 {
+	return math.Pow(16, float64(len(m.prefix)+len(m.suffix)))
+}
+
+// A LeadingZeroBytesMatcher matches hex addresses starting with at least a number of zero bytes.
+type LeadingZeroBytesMatcher struct {
+	zeros string
+}
+
+// Returns a LeadingZeroBytesMatcher requiring count leading zero bytes.
+func NewLeadingZeroBytesMatcher(count int) (*LeadingZeroBytesMatcher, error) {
+	if count <= 0 || count > 20 {
+		return nil, errors.New("count must be between 1 and 20")
+	}
+	return &LeadingZeroBytesMatcher{
+		zeros: strings.Repeat("00", count),
+	}, nil
+}
+
+// Returns true if address has enough leading zero bytes.
+func (m *LeadingZeroBytesMatcher) Match(address string) bool {
+	return strings.HasPrefix(address, m.zeros)
+}
+
+// Returns 256 to the power of number of required zero bytes.
+func (m *LeadingZeroBytesMatcher) Difficulty() float64 {
+	return math.Pow(16, float64(len(m.zeros)))
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import "testing"
+
+func TestPrefixSuffixMatcher(t *testing.T) {
+	tests := []struct {
+		name       string
+		prefix     string
+		suffix     string
+		address    string
+		isMatch    bool
+		difficulty float64
+	}{
+		{"prefix", "0xDEAD", "", "dead5e8a1d5b06b9e5c8b1e6c7e1a9b6f5e7c123", true, 65536},
+		{"suffix", "", "beef", "5e8a1d5b06b9e5c8b1e6c7e1a9b6f5e7c123beef", true, 65536},
+		{"prefix_suffix", "00", "ff", "00a1d5b06b9e5c8b1e6c7e1a9b6f5e7c1230ffff", true, 65536},
+		{"prefix_mismatch", "dead", "", "beef5e8a1d5b06b9e5c8b1e6c7e1a9b6f5e7c123", false, 65536},
+		{"suffix_mismatch", "", "dead", "5e8a1d5b06b9e5c8b1e6c7e1a9b6f5e7c123beef", false, 65536},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := NewPrefixSuffixMatcher(tt.prefix, tt.suffix)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if matcher.Match(tt.address) != tt.isMatch {
+				t.Errorf("invalid match result. expected %v actual %v", tt.isMatch, !tt.isMatch)
+			}
+			if matcher.Difficulty() != tt.difficulty {
+				t.Errorf("invalid difficulty. expected %v actual %v", tt.difficulty, matcher.Difficulty())
+			}
+		})
+	}
+}
+
+func TestNewPrefixSuffixMatcher_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		suffix string
+	}{
+		{"empty", "", ""},
+		{"non_hex", "cafez", ""},
+		{"too_long", "0123456789012345678901234567890123456789", "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewPrefixSuffixMatcher(tt.prefix, tt.suffix); err == nil {
+				t.Errorf("expected error for prefix %q suffix %q", tt.prefix, tt.suffix)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	btcutil "github.com/FactomProject/btcutilecc"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+	"github.com/tforce-io/tf-golib/stdx"
+	"github.com/tyler-smith/go-bip32"
+)
+
+const (
+	DefaultEthereumDerivationPath = "m/44'/60'/0'/0/0"
+)
+
+// mnemonicGenerator derives accounts from random mnemonics. For each mnemonic,
+// addressCount consecutive indexes of the last path component are tried before
+// generating a new mnemonic, which amortizes the cost of seed derivation.
+type mnemonicGenerator struct {
+	password     string
+	parentPath   string
+	firstIndex   uint32
+	isHarden     bool
+	addressCount uint32
+
+	mnemonic string
+	parent   *bip32.Key
+	offset   uint32
+	key      []byte
+	path     string
+	address  []byte
+	pubkey   []byte
+	err      error
+}
+
+// Returns a GeneratorFactory for Ethereum accounts backed by BIP-39 mnemonics, so results can be
+// restored by any wallet from the mnemonic and derivation path. derivationPath is the path of
+// the first account, e.g. DefaultEthereumDerivationPath. If addressCount is greater than 1,
+// the last component of the path is incremented to try more accounts of the same mnemonic.
+// This search is several orders of magnitude slower than NewEthereumGenerator.
+func NewMnemonicGenerator(derivationPath, password string, addressCount uint32) (GeneratorFactory, error) {
+	parts, err := keymngr.ParseDerivationPath(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, errors.New("derivation path must have at least one component")
+	}
+	if addressCount == 0 {
+		addressCount = 1
+	}
+	last := parts[len(parts)-1]
+	if uint64(last.Index)+uint64(addressCount) > uint64(bip32.FirstHardenedChild) {
+		return nil, errors.New("address index range exceeds maximum index")
+	}
+	parentPath := derivationPath[:strings.LastIndex(derivationPath, "/")]
+	return func() (Generator, error) {
+		g := &mnemonicGenerator{
+			password:     password,
+			parentPath:   parentPath,
+			firstIndex:   last.Index,
+			isHarden:     last.IsHarden,
+			addressCount: addressCount,
+			pubkey:       make([]byte, 64),
+		}
+		if err := g.nextMnemonic(); err != nil {
+			return nil, err
+		}
+		return g, nil
+	}, nil
+}
+
+func (g *mnemonicGenerator) Next() string {
+	for {
+		if g.err != nil {
+			return ""
+		}
+		if g.offset >= g.addressCount {
+			if g.err = g.nextMnemonic(); g.err != nil {
+				return ""
+			}
+		}
+		index := g.firstIndex + g.offset
+		g.offset++
+		childIndex := index
+		suffix := ""
+		if g.isHarden {
+			childIndex += bip32.FirstHardenedChild
+			suffix = "'"
+		}
+		child, err := g.parent.NewChildKey(childIndex)
+		if err != nil {
+			// Invalid child key, skip to the next index as wallets do.
+			continue
+		}
+		g.key = child.Key
+		g.path = fmt.Sprintf("%s/%d%s", g.parentPath, index, suffix)
+		x, y := btcutil.Secp256k1().ScalarBaseMult(g.key)
+		g.address = ethereumAddress(x, y, g.pubkey)
+		return hex.EncodeToString(g.address)
+	}
+}
+
+func (g *mnemonicGenerator) Result() *Result {
+	privateKey := make([]byte, 32)
+	copy(privateKey, g.key)
+	address, _ := keymngr.CreateChecksumAddress(hex.EncodeToString(g.address), nil)
+	return &Result{
+		Address:        "0x" + address,
+		Key:            stdx.Bytes(privateKey),
+		Mnemonic:       g.mnemonic,
+		DerivationPath: g.path,
+	}
+}
+
+func (g *mnemonicGenerator) generatesPrivateKeys() {}
+
+// Returns the error which stopped the generator, e.g. a failure of crypto/rand.
+func (g *mnemonicGenerator) Err() error {
+	return g.err
+}
+
+// Generates a new mnemonic and derives the parent key of candidate accounts.
+// Returns error only if crypto/rand fails, there is no safe way to continue.
+func (g *mnemonicGenerator) nextMnemonic() error {
+	for {
+		mnemonic, _, err := keymngr.NewMnemonic()
+		if err != nil {
+			return err
+		}
+		parent, err := keymngr.DeriveKeyFromMnemonic(mnemonic, g.password, g.parentPath)
+		if err != nil {
+			continue
+		}
+		keymngr.WipeKey(g.parent)
+		g.mnemonic = mnemonic
+		g.parent = parent
+		g.offset = 0
+		return nil
+	}
+}
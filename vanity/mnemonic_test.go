@@ -0,0 +1,77 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+func TestSearch_Mnemonic(t *testing.T) {
+	tests := []struct {
+		name           string
+		derivationPath string
+		password       string
+		addressCount   uint32
+		pathPrefix     string
+	}{
+		{"default_path", DefaultEthereumDerivationPath, "", 1, "m/44'/60'/0'/0/0"},
+		{"address_range", "m/44'/60'/0'/0/10", "", 5, "m/44'/60'/0'/0/1"},
+		{"hardened_range", "m/44'/60'/0'", "TREZOR", 3, "m/44'/60'/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory, err := NewMnemonicGenerator(tt.derivationPath, tt.password, tt.addressCount)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			matcher, _ := NewPrefixSuffixMatcher("a", "")
+			results, err := Search(context.Background(), factory, matcher, &Options{Workers: 2})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			result := results[0]
+			if !strings.HasPrefix(result.DerivationPath, tt.pathPrefix) {
+				t.Errorf("invalid derivation path %s", result.DerivationPath)
+			}
+			key, err := keymngr.DeriveKeyFromMnemonic(result.Mnemonic, tt.password, result.DerivationPath)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !bytes.Equal(key.Key, result.Key) {
+				t.Errorf("mnemonic does not restore the private key")
+			}
+			account := keymngr.NewEthereumAccount(keymngr.NewSecp256k1Keypair(key.Key))
+			if account.AddressStr() != result.Address {
+				t.Errorf("mnemonic does not restore the address. expected %s actual %s", result.Address, account.AddressStr())
+			}
+		})
+	}
+}
+
+func TestNewMnemonicGenerator_Invalid(t *testing.T) {
+	tests := []struct {
+		name           string
+		derivationPath string
+		addressCount   uint32
+	}{
+		{"master_key", "m", 1},
+		{"invalid_path", "m/44'/x", 1},
+		{"index_overflow", "m/44'/60'/0'/0/2147483647", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewMnemonicGenerator(tt.derivationPath, "", tt.addressCount); err == nil {
+				t.Errorf("expected error for %s", tt.derivationPath)
+			}
+		})
+	}
+}
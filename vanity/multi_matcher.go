@@ -0,0 +1,269 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// A PatternMatcher is a Matcher which also reports the pattern satisfied by an address.
+// Results found by a PatternMatcher are tagged with the pattern.
+type PatternMatcher interface {
+	Matcher
+	// Returns the satisfied pattern and true if the address is acceptable.
+	MatchPattern(address string) (string, bool)
+}
+
+// A PatternKind defines where a pattern must appear in an address.
+type PatternKind int
+
+const (
+	PrefixPattern PatternKind = iota
+	SuffixPattern
+	ContainsPattern
+)
+
+// A Pattern is a fixed string which must appear in an address at position defined by Kind.
+type Pattern struct {
+	Kind  PatternKind
+	Value string
+}
+
+// Returns a Pattern from string in format "kind:value" where kind is prefix, suffix or contains.
+// If kind is omitted, the pattern is considered a prefix.
+func ParsePattern(str string) (Pattern, error) {
+	kind, value := PrefixPattern, str
+	if i := strings.Index(str, ":"); i >= 0 {
+		switch strings.ToLower(str[:i]) {
+		case "prefix":
+			kind = PrefixPattern
+		case "suffix":
+			kind = SuffixPattern
+		case "contains":
+			kind = ContainsPattern
+		default:
+			return Pattern{}, fmt.Errorf("unknown pattern kind %s", str[:i])
+		}
+		value = str[i+1:]
+	}
+	if kind == PrefixPattern {
+		value = strings.TrimPrefix(value, "0x")
+	}
+	return Pattern{Kind: kind, Value: value}, nil
+}
+
+// Returns the pattern in format "kind:value".
+func (p Pattern) String() string {
+	switch p.Kind {
+	case SuffixPattern:
+		return "suffix:" + p.Value
+	case ContainsPattern:
+		return "contains:" + p.Value
+	default:
+		return "prefix:" + p.Value
+	}
+}
+
+// A MultiMatcher tests an address against many patterns in a single pass.
+// Prefixes and suffixes are looked up in tries walked from both ends of the address,
+// contains patterns are looked up using Aho-Corasick automaton.
+// When several patterns are satisfied, the longest one is reported.
+type MultiMatcher struct {
+	patterns   []Pattern
+	prefixes   *automaton
+	suffixes   *automaton
+	contains   *automaton
+	difficulty float64
+}
+
+// Returns a MultiMatcher for hex addresses. Matching is case-insensitive.
+func NewMultiMatcher(patterns []Pattern) (*MultiMatcher, error) {
+	if len(patterns) == 0 {
+		return nil, errors.New("at least one pattern is required")
+	}
+	m := &MultiMatcher{
+		prefixes: newAutomaton(),
+		suffixes: newAutomaton(),
+		contains: newAutomaton(),
+	}
+	probability := 0.0
+	for i, pattern := range patterns {
+		value := strings.ToLower(pattern.Value)
+		isValid, _ := regexp.MatchString(`^[0-9a-f]{1,40}$`, value)
+		if !isValid {
+			return nil, fmt.Errorf("pattern %s must contain 1 to 40 hex characters", pattern)
+		}
+		pattern.Value = value
+		m.patterns = append(m.patterns, pattern)
+		switch pattern.Kind {
+		case PrefixPattern:
+			m.prefixes.add(value, i)
+		case SuffixPattern:
+			m.suffixes.add(reverseString(value), i)
+		case ContainsPattern:
+			m.contains.add(value, i)
+		default:
+			return nil, fmt.Errorf("unknown pattern kind %d", pattern.Kind)
+		}
+		positions := 1.0
+		if pattern.Kind == ContainsPattern {
+			positions = float64(40 - len(value) + 1)
+		}
+		probability += positions / math.Pow(16, float64(len(value)))
+	}
+	m.contains.build()
+	m.difficulty = 1 / math.Min(probability, 1)
+	return m, nil
+}
+
+// Returns true if address satisfies at least one pattern.
+func (m *MultiMatcher) Match(address string) bool {
+	_, ok := m.MatchPattern(address)
+	return ok
+}
+
+// Returns the longest pattern satisfied by address.
+func (m *MultiMatcher) MatchPattern(address string) (string, bool) {
+	best := -1
+	better := func(index int) {
+		if index < 0 {
+			return
+		}
+		if best < 0 || len(m.patterns[index].Value) > len(m.patterns[best].Value) ||
+			(len(m.patterns[index].Value) == len(m.patterns[best].Value) && index < best) {
+			best = index
+		}
+	}
+	better(m.prefixes.matchAnchored(address, false))
+	better(m.suffixes.matchAnchored(address, true))
+	better(m.contains.matchAnywhere(address))
+	if best < 0 {
+		return "", false
+	}
+	return m.patterns[best].String(), true
+}
+
+// Returns the expected attempts to satisfy any pattern assuming patterns are independent.
+func (m *MultiMatcher) Difficulty() float64 {
+	return m.difficulty
+}
+
+// automaton is a trie over bytes which optionally is completed into Aho-Corasick automaton.
+// Each node stores the longest pattern ending at it, including patterns reachable by suffix links.
+type automaton struct {
+	next   [][256]int32
+	output []int
+	length []int
+}
+
+func newAutomaton() *automaton {
+	a := &automaton{}
+	a.newNode()
+	return a
+}
+
+func (a *automaton) newNode() int32 {
+	a.next = append(a.next, [256]int32{})
+	a.output = append(a.output, -1)
+	a.length = append(a.length, 0)
+	return int32(len(a.next) - 1)
+}
+
+func (a *automaton) add(pattern string, index int) {
+	node := int32(0)
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if a.next[node][c] == 0 {
+			child := a.newNode()
+			a.next[node][c] = child
+		}
+		node = a.next[node][c]
+	}
+	if a.output[node] < 0 || a.length[node] < len(pattern) {
+		a.output[node] = index
+		a.length[node] = len(pattern)
+	}
+}
+
+// Completes missing transitions using suffix links so matchAnywhere runs in a single pass.
+func (a *automaton) build() {
+	link := make([]int32, len(a.next))
+	queue := []int32{}
+	for c := 0; c < 256; c++ {
+		if child := a.next[0][c]; child != 0 {
+			queue = append(queue, child)
+		}
+	}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		fallback := link[node]
+		// Pattern ending at node itself is always longer than the one inherited from fallback.
+		if a.output[node] < 0 {
+			a.output[node] = a.output[fallback]
+			a.length[node] = a.length[fallback]
+		}
+		for c := 0; c < 256; c++ {
+			child := a.next[node][c]
+			if child == 0 {
+				a.next[node][c] = a.next[fallback][c]
+				continue
+			}
+			link[child] = a.next[fallback][c]
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Walks the trie from the start of text, or from the end if reverse is true,
+// and returns the longest pattern found. Returns -1 if there is none.
+func (a *automaton) matchAnchored(text string, reverse bool) int {
+	node := int32(0)
+	result := -1
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if reverse {
+			c = text[len(text)-1-i]
+		}
+		node = a.next[node][c]
+		if node == 0 {
+			break
+		}
+		if a.output[node] >= 0 {
+			result = a.output[node]
+		}
+	}
+	return result
+}
+
+// Scans text using the completed automaton and returns the longest pattern found. Returns -1 if there is none.
+func (a *automaton) matchAnywhere(text string) int {
+	if len(a.next) == 1 {
+		return -1
+	}
+	node := int32(0)
+	result, length := -1, 0
+	for i := 0; i < len(text); i++ {
+		node = a.next[node][text[i]]
+		if a.output[node] >= 0 && a.length[node] > length {
+			result, length = a.output[node], a.length[node]
+		}
+	}
+	return result
+}
+
+func reverseString(str string) string {
+	chars := []byte(str)
+	for i, j := 0, len(chars)-1; i < j; i, j = i+1, j-1 {
+		chars[i], chars[j] = chars[j], chars[i]
+	}
+	return string(chars)
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestMultiMatcher_MatchPattern(t *testing.T) {
+	patterns := []string{"dead", "prefix:0xbeef", "suffix:cafe", "suffix:babe", "contains:c0ffee", "contains:ffee", "de"}
+	tests := []struct {
+		name     string
+		address  string
+		expected string
+		isMatch  bool
+	}{
+		{"prefix", "dead000000000000000000000000000000000000", "prefix:dead", true},
+		{"short_prefix", "de00000000000000000000000000000000000000", "prefix:de", true},
+		{"suffix", "000000000000000000000000000000000000cafe", "suffix:cafe", true},
+		{"contains", "000000000000000c0ffee0000000000000000000", "contains:c0ffee", true},
+		{"contains_overlap", "0000000000000000ffee00000000000000000000", "contains:ffee", true},
+		{"longest_wins", "dead00000000000000000000000c0ffee0000000", "contains:c0ffee", true},
+		{"no_match", "0000000000000000000000000000000000000000", "", false},
+	}
+	var parsed []Pattern
+	for _, str := range patterns {
+		pattern, _ := ParsePattern(str)
+		parsed = append(parsed, pattern)
+	}
+	matcher, err := NewMultiMatcher(parsed)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern, ok := matcher.MatchPattern(tt.address)
+			if ok != tt.isMatch || pattern != tt.expected {
+				t.Errorf("invalid match result. expected %s actual %s", tt.expected, pattern)
+			}
+		})
+	}
+}
+
+func TestMultiMatcher_BruteForce(t *testing.T) {
+	var patterns []Pattern
+	for _, str := range []string{"0", "prefix:a1", "suffix:1", "suffix:f2", "contains:abc", "contains:bc", "contains:00", "contains:000"} {
+		pattern, _ := ParsePattern(str)
+		patterns = append(patterns, pattern)
+	}
+	matcher, _ := NewMultiMatcher(patterns)
+	buffer := make([]byte, 20)
+	for i := 0; i < 10000; i++ {
+		rand.Read(buffer)
+		address := hex.EncodeToString(buffer)
+		expected := false
+		for _, pattern := range patterns {
+			switch pattern.Kind {
+			case PrefixPattern:
+				expected = expected || strings.HasPrefix(address, pattern.Value)
+			case SuffixPattern:
+				expected = expected || strings.HasSuffix(address, pattern.Value)
+			case ContainsPattern:
+				expected = expected || strings.Contains(address, pattern.Value)
+			}
+		}
+		if matcher.Match(address) != expected {
+			t.Fatalf("invalid match result for %s. expected %v", address, expected)
+		}
+	}
+}
+
+func TestSearch_MultiMatcher(t *testing.T) {
+	var patterns []Pattern
+	for _, str := range []string{"a0", "b1", "suffix:c2"} {
+		pattern, _ := ParsePattern(str)
+		patterns = append(patterns, pattern)
+	}
+	matcher, _ := NewMultiMatcher(patterns)
+	results, err := Search(context.Background(), NewEthereumGenerator(), matcher, &Options{Workers: 2, MaxResults: 5})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	for _, result := range results {
+		address := strings.ToLower(strings.TrimPrefix(result.Address, "0x"))
+		pattern, _ := ParsePattern(result.Pattern)
+		isMatch := strings.HasPrefix(address, pattern.Value)
+		if pattern.Kind == SuffixPattern {
+			isMatch = strings.HasSuffix(address, pattern.Value)
+		}
+		if result.Pattern == "" || !isMatch {
+			t.Errorf("result %s is not tagged with satisfied pattern. actual %q", result.Address, result.Pattern)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package vanity provides APIs to search for vanity addresses using all available CPU cores.
+
+A search is driven by a Generator which produces candidate addresses and a Matcher which
+decides whether a candidate is acceptable. The following searches are supported:
+Ethereum accounts (EOA) and contract addresses deployed by CREATE2 opcode.
+Ethereum accounts can also be derived from random BIP-39 mnemonics, which is much slower
+but results can be restored from a seed phrase backup by any wallet.
+Bitcoin legacy, native segwit and taproot addresses are supported by BitcoinMatcher.
+Solana addresses are searched by NewSolanaGenerator and SolanaMatcher, and results are written
+as Solana CLI keypair files by SolanaKeypairJSON. Tron addresses are searched by NewTronGenerator
+and TronMatcher.
+Private keys found by account searches are checked by package audit and weak keys,
+which could only come from a broken generator, are never emitted.
+
+Besides exact patterns, SearchBest runs until cancelled and keeps the best addresses
+rated by a Scorer, e.g. addresses with the most leading zero bytes to save gas.
+
+Long running searches can be run as a Job which checkpoints its progress to a state file
+and can be resumed after restart. Found keys are encrypted with a password before written.
+
+A search can be distributed to other machines with a Coordinator serving HTTP and workers
+started by RunWorker. Using split-key target, workers search offsets for a public key so
+the final private key is only known to the requester, see CombineSplitKey. The Coordinator API
+is not authenticated and must only be served on a trusted network.
+*/
+package vanity
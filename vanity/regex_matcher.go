@@ -0,0 +1,365 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+const (
+	ethereumAddressLength = 40
+)
+
+// A RegexMatcher matches hex addresses using a regular expression.
+// In checksum mode, the expression is evaluated against EIP-55 checksum address
+// so letter case is significant, otherwise against lowercase address.
+// Addresses are matched without 0x prefix.
+type RegexMatcher struct {
+	pattern    string
+	checksum   bool
+	regex      *regexp.Regexp
+	prefilter  *regexp.Regexp
+	difficulty float64
+}
+
+// Returns a RegexMatcher from a regular expression following Go RE2 syntax.
+// Difficulty is estimated if the expression is a sequence of literals, character classes,
+// fixed repetitions and .* gaps, otherwise it is reported as unknown.
+func NewRegexMatcher(expr string, checksum bool) (*RegexMatcher, error) {
+	regex, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	m := &RegexMatcher{
+		pattern:  expr,
+		checksum: checksum,
+		regex:    regex,
+	}
+	if checksum {
+		// Checksum is expensive, so only compute it for addresses matching case-insensitively.
+		m.prefilter, _ = regexp.Compile("(?i)" + expr)
+	}
+	m.difficulty = estimateDifficulty(expr, ethereumAddressLength, checksum)
+	return m, nil
+}
+
+// Returns a RegexMatcher from a wildcard pattern. Supported syntax:
+//
+//	?        any single character
+//	*        any run of characters, including empty
+//	[0-7]    character class, [^0] for negation
+//	{ab}     group of literal characters
+//	{6}      repeat previous character, class or group 6 times
+//	$        at the end, anchor the pattern to the end of the address
+//
+// The pattern is anchored to the start of the address, 0x prefix is optional.
+// Examples: "0x??dead", "0x{a}{6}", "*beef$", "[0-3]{4}cafe".
+func NewWildcardMatcher(pattern string, checksum bool) (*RegexMatcher, error) {
+	expr, err := compileWildcard(pattern, checksum)
+	if err != nil {
+		return nil, err
+	}
+	m, err := NewRegexMatcher(expr, checksum)
+	if err != nil {
+		return nil, err
+	}
+	m.pattern = pattern
+	return m, nil
+}
+
+// Returns true if address matches the expression.
+func (m *RegexMatcher) Match(address string) bool {
+	if !m.checksum {
+		return m.regex.MatchString(address)
+	}
+	if !m.prefilter.MatchString(address) {
+		return false
+	}
+	checksumAddress, err := keymngr.CreateChecksumAddress(address, nil)
+	if err != nil {
+		return false
+	}
+	return m.regex.MatchString(checksumAddress)
+}
+
+// Returns the original pattern if address matches the expression.
+func (m *RegexMatcher) MatchPattern(address string) (string, bool) {
+	if !m.Match(address) {
+		return "", false
+	}
+	return m.pattern, true
+}
+
+// Returns estimated attempts to find one match. Zero if the expression is too complex to estimate.
+func (m *RegexMatcher) Difficulty() float64 {
+	return m.difficulty
+}
+
+// Translates wildcard pattern into an anchored regular expression.
+func compileWildcard(pattern string, checksum bool) (string, error) {
+	body := strings.TrimPrefix(pattern, "0x")
+	anchorEnd := strings.HasSuffix(body, "$")
+	body = strings.TrimSuffix(body, "$")
+	if body == "" {
+		return "", errors.New("empty pattern")
+	}
+	if !checksum {
+		body = strings.ToLower(body)
+	}
+	var sb strings.Builder
+	sb.WriteString("^")
+	hasElement := false
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case isHexChar(c):
+			sb.WriteByte(c)
+			hasElement = true
+		case c == '?':
+			sb.WriteString(".")
+			hasElement = true
+		case c == '*':
+			sb.WriteString(".*")
+			hasElement = false
+		case c == '[':
+			end := strings.IndexByte(body[i:], ']')
+			if end < 0 {
+				return "", errors.New("unterminated character class")
+			}
+			class := body[i+1 : i+end]
+			members := strings.TrimPrefix(class, "^")
+			if members == "" {
+				return "", errors.New("empty character class")
+			}
+			for j := 0; j < len(members); j++ {
+				if !isHexChar(members[j]) && members[j] != '-' {
+					return "", fmt.Errorf("invalid character %q in character class", members[j])
+				}
+			}
+			sb.WriteString("[" + class + "]")
+			i += end
+			hasElement = true
+		case c == '{':
+			end := strings.IndexByte(body[i:], '}')
+			if end < 0 {
+				return "", errors.New("unterminated brace")
+			}
+			content := body[i+1 : i+end]
+			if content == "" {
+				return "", errors.New("empty brace")
+			}
+			if isDigits(content) {
+				if !hasElement {
+					return "", errors.New("repetition must follow a character, class or group")
+				}
+				sb.WriteString("{" + content + "}")
+				hasElement = false
+			} else {
+				for j := 0; j < len(content); j++ {
+					if !isHexChar(content[j]) {
+						return "", fmt.Errorf("invalid character %q in group", content[j])
+					}
+				}
+				sb.WriteString("(?:" + content + ")")
+				hasElement = true
+			}
+			i += end
+		default:
+			return "", fmt.Errorf("invalid character %q in pattern", c)
+		}
+	}
+	if anchorEnd {
+		sb.WriteString("$")
+	}
+	return sb.String(), nil
+}
+
+// Returns estimated attempts for expr to match a random address of length characters.
+// Returns 0 if the expression is not a sequence of single character elements and .* or .+ gaps.
+func estimateDifficulty(expr string, length int, checksum bool) float64 {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return 0
+	}
+	re = re.Simplify()
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+	anchorStart, anchorEnd := false, false
+	if len(subs) > 0 && subs[0].Op == syntax.OpBeginText {
+		anchorStart = true
+		subs = subs[1:]
+	}
+	if len(subs) > 0 && subs[len(subs)-1].Op == syntax.OpEndText {
+		anchorEnd = true
+		subs = subs[:len(subs)-1]
+	}
+	// Split elements into segments separated by .* gaps.
+	type segment struct {
+		probability float64
+		length      int
+	}
+	segments := []segment{{probability: 1}}
+	leadingGap, trailingGap := false, false
+	for i, sub := range subs {
+		if minLength, ok := anyRunMinLength(sub); ok {
+			if i == 0 {
+				leadingGap = true
+			}
+			if i == len(subs)-1 {
+				trailingGap = true
+			}
+			// Mandatory characters of the run, e.g. one for .+, are part of the fixed length.
+			segments[len(segments)-1].length += minLength
+			segments = append(segments, segment{probability: 1})
+			continue
+		}
+		probability, count, ok := elementProbability(sub, checksum)
+		if !ok {
+			return 0
+		}
+		last := &segments[len(segments)-1]
+		last.probability *= probability
+		last.length += count
+	}
+	fixedLength := 0
+	for _, seg := range segments {
+		fixedLength += seg.length
+	}
+	if fixedLength > length || (len(segments) == 1 && anchorStart && anchorEnd && fixedLength != length) {
+		return math.Inf(1)
+	}
+	probability := 1.0
+	for i, seg := range segments {
+		// Segments of any characters match at every position.
+		if seg.length == 0 || seg.probability == 1 {
+			continue
+		}
+		isAnchored := (i == 0 && anchorStart && !leadingGap) ||
+			(i == len(segments)-1 && anchorEnd && !trailingGap)
+		probability *= seg.probability
+		if !isAnchored {
+			probability *= float64(length - fixedLength + 1)
+		}
+	}
+	if probability >= 1 {
+		return 1
+	}
+	return 1 / probability
+}
+
+// Returns match probability and number of characters consumed by a single element.
+func elementProbability(re *syntax.Regexp, checksum bool) (float64, int, bool) {
+	foldCase := re.Flags&syntax.FoldCase != 0
+	switch re.Op {
+	case syntax.OpLiteral:
+		probability := 1.0
+		for _, r := range re.Rune {
+			if foldCase {
+				r = unicode.ToLower(r)
+			}
+			probability *= charProbability(r, checksum && !foldCase)
+		}
+		return probability, len(re.Rune), true
+	case syntax.OpCharClass:
+		probability := 0.0
+		for _, r := range "0123456789abcdefABCDEF" {
+			if !checksum && r >= 'A' && r <= 'F' {
+				continue
+			}
+			for i := 0; i+1 < len(re.Rune); i += 2 {
+				if r >= re.Rune[i] && r <= re.Rune[i+1] {
+					probability += charProbability(r, checksum)
+					break
+				}
+			}
+		}
+		return math.Min(probability, 1), 1, true
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return 1, 1, true
+	case syntax.OpCapture:
+		return elementProbability(re.Sub[0], checksum)
+	case syntax.OpConcat:
+		probability, count := 1.0, 0
+		for _, sub := range re.Sub {
+			p, c, ok := elementProbability(sub, checksum)
+			if !ok {
+				return 0, 0, false
+			}
+			probability *= p
+			count += c
+		}
+		return probability, count, true
+	case syntax.OpRepeat:
+		if re.Min != re.Max {
+			return 0, 0, false
+		}
+		p, c, ok := elementProbability(re.Sub[0], checksum)
+		if !ok {
+			return 0, 0, false
+		}
+		return math.Pow(p, float64(re.Min)), c * re.Min, true
+	case syntax.OpEmptyMatch:
+		return 1, 0, true
+	}
+	return 0, 0, false
+}
+
+// Returns probability of a random address character equals r.
+// In case-sensitive checksum mode, a letter has equal chance to be lowercase or uppercase.
+func charProbability(r rune, caseSensitive bool) float64 {
+	switch {
+	case r >= '0' && r <= '9':
+		return 1.0 / 16
+	case r >= 'a' && r <= 'f', r >= 'A' && r <= 'F':
+		if caseSensitive {
+			return 1.0 / 32
+		}
+		if r >= 'A' && r <= 'F' {
+			return 0
+		}
+		return 1.0 / 16
+	}
+	return 0
+}
+
+// Returns the minimum length of re and true if re matches any run of characters like .* or .+ does.
+// .? is not a run because its length is bounded.
+func anyRunMinLength(re *syntax.Regexp) (int, bool) {
+	minLength := 0
+	switch re.Op {
+	case syntax.OpStar:
+	case syntax.OpPlus:
+		minLength = 1
+	default:
+		return 0, false
+	}
+	sub := re.Sub[0]
+	return minLength, sub.Op == syntax.OpAnyChar || sub.Op == syntax.OpAnyCharNotNL
+}
+
+func isDigits(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isHexChar(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewWildcardMatcher(t *testing.T) {
+	tests := []struct {
+		name       string
+		pattern    string
+		checksum   bool
+		address    string
+		isMatch    bool
+		difficulty float64
+	}{
+		{"wildcard", "0x??dead", false, "00dead0000000000000000000000000000000000", true, 65536},
+		{"wildcard_mismatch", "0x??dead", false, "000dead000000000000000000000000000000000", false, 65536},
+		{"repeat", "0x{a}{6}", false, "aaaaaa0000000000000000000000000000000000", true, 16777216},
+		{"group_repeat", "{ab}{2}", false, "abab000000000000000000000000000000000000", true, 65536},
+		{"class", "[0-3]{4}cafe", false, "0123cafe00000000000000000000000000000000", true, 65536 * 256},
+		{"class_negation", "[^0]", false, "0000000000000000000000000000000000000000", false, 16.0 / 15},
+		{"suffix", "*beef$", false, "000000000000000000000000000000000000beef", true, 65536},
+		{"prefix_suffix", "dead*beef$", false, "dead00000000000000000000000000000000beef", true, 65536 * 65536},
+		{"contains", "*c0ffee", false, "00000000000000000c0ffee00000000000000000", true, 16777216.0 / 35},
+		{"uppercase_lowered", "0xDEAD", false, "dead000000000000000000000000000000000000", true, 65536},
+		{"checksum", "114A", true, "114a781017506df34b3ed4c0e6b438889a6eb3f7", true, 16 * 16 * 16 * 32},
+		{"checksum_mismatch", "114a", true, "114a781017506df34b3ed4c0e6b438889a6eb3f7", false, 16 * 16 * 16 * 32},
+		{"checksum_class", "114[A-F]", true, "114a781017506df34b3ed4c0e6b438889a6eb3f7", true, 16 * 16 * 16 * 32.0 / 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := NewWildcardMatcher(tt.pattern, tt.checksum)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if matcher.Match(tt.address) != tt.isMatch {
+				t.Errorf("invalid match result. expected %v actual %v", tt.isMatch, !tt.isMatch)
+			}
+			if math.Abs(matcher.Difficulty()-tt.difficulty) > 1e-6*tt.difficulty {
+				t.Errorf("invalid difficulty. expected %v actual %v", tt.difficulty, matcher.Difficulty())
+			}
+		})
+	}
+}
+
+func TestNewWildcardMatcher_Invalid(t *testing.T) {
+	for _, pattern := range []string{"", "0xg", "[0-3", "{3}", "{ag}", "dead{2"} {
+		if _, err := NewWildcardMatcher(pattern, false); err == nil {
+			t.Errorf("expected error for pattern %q", pattern)
+		}
+	}
+}
+
+func TestNewRegexMatcher(t *testing.T) {
+	tests := []struct {
+		name       string
+		expr       string
+		checksum   bool
+		address    string
+		isMatch    bool
+		difficulty float64
+	}{
+		{"anchored", "^dead", false, "dead000000000000000000000000000000000000", true, 65536},
+		{"unanchored", "dead", false, "0000dead00000000000000000000000000000000", true, 65536.0 / 37},
+		{"repeat", "^0{8}", false, "0000000000000000000000000000000000000000", true, math.Pow(16, 8)},
+		{"alternation", "^(dead|beef)", false, "beef000000000000000000000000000000000000", true, 0},
+		{"case_insensitive", "(?i)^DEAD", true, "dead000000000000000000000000000000000000", true, 65536},
+		{"checksum", "^114A78", true, "114a781017506df34b3ed4c0e6b438889a6eb3f7", true, 16 * 16 * 16 * 32 * 16 * 16},
+		{"any_star", "^.*dead$", false, "000000000000000000000000000000000000dead", true, 65536},
+		{"any_plus", "^.+dead", false, "0dead000000000000000000000000000000000000", true, 65536.0 / 36},
+		{"any_plus_no_room", "^.+dead", false, "dead000000000000000000000000000000000000", false, 65536.0 / 36},
+		{"any_plus_between", "^dead.+beef$", false, "dead00000000000000000000000000000000beef", true, 65536 * 65536},
+		{"any_quest", "^.?dead", false, "dead000000000000000000000000000000000000", true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := NewRegexMatcher(tt.expr, tt.checksum)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if matcher.Match(tt.address) != tt.isMatch {
+				t.Errorf("invalid match result. expected %v actual %v", tt.isMatch, !tt.isMatch)
+			}
+			if math.Abs(matcher.Difficulty()-tt.difficulty) > 1e-6*tt.difficulty {
+				t.Errorf("invalid difficulty. expected %v actual %v", tt.difficulty, matcher.Difficulty())
+			}
+		})
+	}
+}
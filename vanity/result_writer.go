@@ -0,0 +1,66 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// A ResultWriter writes results to an underlying writer as JSON lines.
+// Each line is written in a single call so results are preserved if the process is interrupted.
+// ResultWriter is safe for concurrent use.
+type ResultWriter struct {
+	w  io.Writer
+	mu sync.Mutex
+}
+
+// Returns a ResultWriter writing to w.
+func NewResultWriter(w io.Writer) *ResultWriter {
+	return &ResultWriter{
+		w: w,
+	}
+}
+
+// Writes result as a JSON line. Key is written in hex without 0x prefix.
+// Score is only written for results of a Scorer.
+func (w *ResultWriter) Write(result *Result) error {
+	var score *float64
+	if result.IsScored {
+		score = &result.Score
+	}
+	line, err := json.Marshal(&jsonResult{
+		Address:  result.Address,
+		Key:      result.Key.HexStr(),
+		Score:    score,
+		Pattern:  result.Pattern,
+		Mnemonic: result.Mnemonic,
+		Path:     result.DerivationPath,
+		Attempts: result.Attempts,
+		Time:     time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, err = w.w.Write(append(line, '\n'))
+	return err
+}
+
+type jsonResult struct {
+	Address  string   `json:"address"`
+	Key      string   `json:"key"`
+	Score    *float64 `json:"score,omitempty"`
+	Pattern  string   `json:"pattern,omitempty"`
+	Mnemonic string   `json:"mnemonic,omitempty"`
+	Path     string   `json:"path,omitempty"`
+	Attempts uint64   `json:"attempts"`
+	Time     string   `json:"time"`
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestResultWriter_Write(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   *Result
+		hasScore bool
+		score    float64
+	}{
+		{"unscored", &Result{Address: "0xab", Key: []byte{0x01}}, false, 0},
+		{"zero_score", &Result{Address: "0xab", Key: []byte{0x01}, IsScored: true}, true, 0},
+		{"positive_score", &Result{Address: "0xab", Key: []byte{0x01}, Score: 2.5, IsScored: true}, true, 2.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			if err := NewResultWriter(&output).Write(tt.result); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			line := map[string]interface{}{}
+			if err := json.Unmarshal(output.Bytes(), &line); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			score, hasScore := line["score"]
+			if hasScore != tt.hasScore || (hasScore && score != tt.score) {
+				t.Errorf("invalid score. expected %v actual %v", tt.score, score)
+			}
+			if line["key"] != "01" || line["address"] != "0xab" {
+				t.Errorf("invalid line %s", output.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// A Scorer rates a candidate address, higher score is better.
+// For Ethereum, addresses are passed to Scorer in lowercase hex without 0x prefix.
+// Scorer must be safe for concurrent use.
+type Scorer interface {
+	Score(address string) float64
+}
+
+// A ScorerFunc is an adapter to use ordinary function as Scorer.
+type ScorerFunc func(address string) float64
+
+// Returns f(address).
+func (f ScorerFunc) Score(address string) float64 {
+	return f(address)
+}
+
+// Scores the number of leading zero hex characters.
+// Each leading zero nibble saves gas when the address is used in calldata packing.
+var LeadingZeroNibblesScorer = ScorerFunc(func(address string) float64 {
+	count := 0
+	for count < len(address) && address[count] == '0' {
+		count++
+	}
+	return float64(count)
+})
+
+// Scores the number of leading zero bytes.
+var LeadingZeroBytesScorer = ScorerFunc(func(address string) float64 {
+	count := 0
+	for 2*count+1 < len(address) && address[2*count] == '0' && address[2*count+1] == '0' {
+		count++
+	}
+	return float64(count)
+})
+
+// Scores the total number of zero bytes at any position.
+// Each zero byte in calldata costs 4 gas instead of 16 gas.
+var ZeroBytesScorer = ScorerFunc(func(address string) float64 {
+	count := 0
+	for i := 0; i+1 < len(address); i += 2 {
+		if address[i] == '0' && address[i+1] == '0' {
+			count++
+		}
+	}
+	return float64(count)
+})
+
+// Built-in scorers which can be referenced by name in ParseScorer.
+var scorers = map[string]Scorer{
+	"leading-zero-nibbles": LeadingZeroNibblesScorer,
+	"leading-zero-bytes":   LeadingZeroBytesScorer,
+	"zero-bytes":           ZeroBytesScorer,
+}
+
+// A WeightedTerm is a Scorer with its weight in a WeightedScorer.
+type WeightedTerm struct {
+	Scorer Scorer
+	Weight float64
+}
+
+// A WeightedScorer sums scores of all terms multiplied by their weights.
+type WeightedScorer struct {
+	terms []WeightedTerm
+}
+
+// Returns a WeightedScorer from terms.
+func NewWeightedScorer(terms ...WeightedTerm) *WeightedScorer {
+	return &WeightedScorer{
+		terms: terms,
+	}
+}
+
+// Returns the weighted sum of scores.
+func (s *WeightedScorer) Score(address string) float64 {
+	score := 0.0
+	for _, term := range s.terms {
+		score += term.Weight * term.Scorer.Score(address)
+	}
+	return score
+}
+
+// Returns a Scorer from specification in format "name[:weight],name[:weight]".
+// Available names are leading-zero-nibbles, leading-zero-bytes and zero-bytes.
+// Weight defaults to 1 if omitted.
+// Example: "leading-zero-bytes:4,zero-bytes:1".
+func ParseScorer(spec string) (Scorer, error) {
+	var terms []WeightedTerm
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, weightStr := part, "1"
+		if i := strings.Index(part, ":"); i >= 0 {
+			name, weightStr = part[:i], part[i+1:]
+		}
+		scorer, ok := scorers[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown scorer %s", name)
+		}
+		weight, err := strconv.ParseFloat(weightStr, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of scorer %s", name)
+		}
+		terms = append(terms, WeightedTerm{Scorer: scorer, Weight: weight})
+	}
+	if len(terms) == 0 {
+		return nil, errors.New("at least one scorer is required")
+	}
+	if len(terms) == 1 && terms[0].Weight == 1 {
+		return terms[0].Scorer, nil
+	}
+	return NewWeightedScorer(terms...), nil
+}
+
+// Runs a search which keeps the best scoring results until ctx is cancelled.
+// Every time a result enters the leaderboard, it is passed to onImprove if not nil.
+// Returns at most options.Keep results sorted by score in descending order.
+func SearchBest(ctx context.Context, newGenerator GeneratorFactory, scorer Scorer, onImprove func(*Result), options *Options) ([]*Result, error) {
+	if newGenerator == nil || scorer == nil {
+		return nil, errors.New("generator and scorer are required")
+	}
+	opts := normalizeOptions(options)
+	var threshold uint64
+	atomic.StoreUint64(&threshold, math.Float64bits(math.Inf(-1)))
+	filter := func(address string) (float64, string, bool) {
+		score := scorer.Score(address)
+		return score, "", score > math.Float64frombits(atomic.LoadUint64(&threshold))
+	}
+	results := []*Result{}
+	collect := func(result *Result) bool {
+		result.IsScored = true
+		if len(results) >= opts.Keep && result.Score <= results[len(results)-1].Score {
+			return false
+		}
+		results = append(results, result)
+		sort.SliceStable(results, func(i, j int) bool {
+			return results[i].Score > results[j].Score
+		})
+		if len(results) > opts.Keep {
+			results = results[:opts.Keep]
+		}
+		if len(results) >= opts.Keep {
+			atomic.StoreUint64(&threshold, math.Float64bits(results[len(results)-1].Score))
+		}
+		if onImprove != nil {
+			onImprove(result)
+		}
+		return false
+	}
+	_, err := run(ctx, newGenerator, opts, 0, filter, collect)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
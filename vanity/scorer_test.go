@@ -0,0 +1,84 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScorers(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		address  string
+		expected float64
+	}{
+		{"leading_zero_nibbles", "leading-zero-nibbles", "000a00000000000000000000000000000000ffff", 3},
+		{"leading_zero_bytes", "leading-zero-bytes", "000a00000000000000000000000000000000ffff", 1},
+		{"leading_zero_bytes_odd", "leading-zero-bytes", "0000000a000000000000000000000000000000ff", 3},
+		{"zero_bytes", "zero-bytes", "000a00000000000000000000000000000000ffff", 17},
+		{"zero_bytes_unaligned", "zero-bytes", "a00a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a", 0},
+		{"weighted", "leading-zero-bytes:4, zero-bytes", "0000000a000000000000000000000000000000ff", 4*3 + 18},
+		{"weighted_fraction", "leading-zero-nibbles:0.5", "0000000a000000000000000000000000000000ff", 3.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scorer, err := ParseScorer(tt.spec)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if scorer.Score(tt.address) != tt.expected {
+				t.Errorf("invalid score. expected %v actual %v", tt.expected, scorer.Score(tt.address))
+			}
+		})
+	}
+}
+
+func TestParseScorer_Invalid(t *testing.T) {
+	for _, spec := range []string{"", "unknown", "zero-bytes:abc"} {
+		if _, err := ParseScorer(spec); err == nil {
+			t.Errorf("expected error for spec %q", spec)
+		}
+	}
+}
+
+func TestSearchBest(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	var output bytes.Buffer
+	writer := NewResultWriter(&output)
+	improvements := 0
+	results, err := SearchBest(ctx, NewEthereumGenerator(), LeadingZeroNibblesScorer, func(result *Result) {
+		improvements++
+		writer.Write(result)
+	}, &Options{Workers: 2, Keep: 3})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("invalid number of results. expected 3 actual %d", len(results))
+	}
+	for i := 1; i < len(results); i++ {
+		if results[i-1].Score < results[i].Score {
+			t.Errorf("results are not sorted by score")
+		}
+	}
+	for _, result := range results {
+		address := strings.ToLower(strings.TrimPrefix(result.Address, "0x"))
+		if LeadingZeroNibblesScorer.Score(address) != result.Score {
+			t.Errorf("invalid score of %s. actual %v", result.Address, result.Score)
+		}
+	}
+	lines := strings.Count(output.String(), "\n")
+	if lines != improvements {
+		t.Errorf("invalid number of streamed results. expected %d actual %d", improvements, lines)
+	}
+}
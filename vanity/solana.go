@@ -0,0 +1,192 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lukaz17/cryptotool-go/base58"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const (
+	// Length of Ed25519 public key encoded in Solana addresses.
+	solanaPublicKeyLength = 32
+	// Maximum number of case variants of a case-insensitive prefix whose probabilities are summed.
+	maxCaseVariants = 1 << 16
+)
+
+// solanaGenerator draws a fresh private key seed from crypto/rand for every candidate.
+// Ed25519 hashes the seed before scalar multiplication, so walking related seeds would not
+// save any work, and it would let anyone holding one result find the others of the same worker.
+type solanaGenerator struct {
+	seed    []byte
+	address string
+	err     error
+}
+
+// Returns a GeneratorFactory for Solana addresses. Every candidate uses an independent seed
+// read from crypto/rand. Result.Key is the 32-byte private key seed, see SolanaKeypairJSON.
+func NewSolanaGenerator() GeneratorFactory {
+	return func() (Generator, error) {
+		seed := make([]byte, ed25519.SeedSize)
+		if _, err := rand.Read(seed); err != nil {
+			return nil, err
+		}
+		return &solanaGenerator{seed: seed}, nil
+	}
+}
+
+func (g *solanaGenerator) Next() string {
+	if _, err := rand.Read(g.seed); err != nil {
+		g.err = err
+		return ""
+	}
+	privateKey := ed25519.NewKeyFromSeed(g.seed)
+	g.address = base58.Encode(privateKey[ed25519.SeedSize:])
+	secmem.Wipe(privateKey)
+	return g.address
+}
+
+func (g *solanaGenerator) Result() *Result {
+	return &Result{
+		Address: g.address,
+		Key:     append(stdx.Bytes{}, g.seed...),
+	}
+}
+
+// Returns the error of crypto/rand which stopped the generator.
+func (g *solanaGenerator) Err() error {
+	return g.err
+}
+
+// Returns the keypair file of Solana CLI for a result of a Solana search.
+// The file contains the private key in plaintext, callers should wipe it with secmem.Wipe after use.
+func SolanaKeypairJSON(result *Result) ([]byte, error) {
+	keypair, err := keymngr.NewEd25519Keypair(result.Key, "")
+	if err != nil {
+		return nil, err
+	}
+	account := keymngr.NewSolanaAccount(keypair)
+	defer account.Destroy()
+	if account.Address() != result.Address {
+		return nil, errors.New("private key does not match address")
+	}
+	return account.KeypairJSON(), nil
+}
+
+// A SolanaMatcher matches Base58 Solana addresses by prefix and suffix.
+type SolanaMatcher struct {
+	prefix          string
+	suffix          string
+	caseInsensitive bool
+	difficulty      float64
+}
+
+// Returns a SolanaMatcher for addresses starting with prefix and ending with suffix.
+// Case-sensitive patterns must only contain Base58 characters, which exclude 0, O, I and l.
+// Case-insensitive patterns are rejected only if a character has no Base58 form in any case.
+func NewSolanaMatcher(prefix, suffix string, caseInsensitive bool) (*SolanaMatcher, error) {
+	if prefix == "" && suffix == "" {
+		return nil, errors.New("prefix or suffix is required")
+	}
+	if caseInsensitive {
+		prefix, suffix = strings.ToLower(prefix), strings.ToLower(suffix)
+	}
+	for _, pattern := range []string{prefix, suffix} {
+		for i := 0; i < len(pattern); i++ {
+			if len(caseVariants(pattern[i], caseInsensitive)) == 0 {
+				return nil, fmt.Errorf("invalid character %q in pattern, Base58 does not use 0, O, I and l", pattern[i])
+			}
+		}
+	}
+	difficulty, err := solanaDifficulty(prefix, suffix, caseInsensitive)
+	if err != nil {
+		return nil, err
+	}
+	return &SolanaMatcher{
+		prefix:          prefix,
+		suffix:          suffix,
+		caseInsensitive: caseInsensitive,
+		difficulty:      difficulty,
+	}, nil
+}
+
+// Returns true if address starts with prefix and ends with suffix.
+func (m *SolanaMatcher) Match(address string) bool {
+	if m.caseInsensitive {
+		address = strings.ToLower(address)
+	}
+	return strings.HasPrefix(address, m.prefix) && strings.HasSuffix(address, m.suffix)
+}
+
+// Returns expected attempts to find one match.
+func (m *SolanaMatcher) Difficulty() float64 {
+	return m.difficulty
+}
+
+// Returns expected attempts for a Solana pattern. The probability of prefix is computed by counting
+// public keys whose encoding starts with prefix, summed over all case variants if caseInsensitive.
+func solanaDifficulty(prefix, suffix string, caseInsensitive bool) (float64, error) {
+	variants := []string{""}
+	for i := 0; i < len(prefix); i++ {
+		chars := caseVariants(prefix[i], caseInsensitive)
+		if len(variants)*len(chars) > maxCaseVariants {
+			return 0, errors.New("case-insensitive prefix is too long")
+		}
+		next := make([]string, 0, len(variants)*len(chars))
+		for _, variant := range variants {
+			for _, c := range chars {
+				next = append(next, variant+string(c))
+			}
+		}
+		variants = next
+	}
+	count := new(big.Int)
+	for _, variant := range variants {
+		count.Add(count, base58PayloadPrefixCount(variant, solanaPublicKeyLength))
+	}
+	if count.Sign() == 0 {
+		return 0, errors.New("pattern can never match")
+	}
+	total := new(big.Int).Lsh(big.NewInt(1), 8*solanaPublicKeyLength)
+	probability, _ := new(big.Float).Quo(new(big.Float).SetInt(count), new(big.Float).SetInt(total)).Float64()
+	// The last digits of a large uniform number are close to uniform.
+	for i := 0; i < len(suffix); i++ {
+		probability *= float64(len(caseVariants(suffix[i], caseInsensitive))) / 58
+	}
+	if probability == 0 {
+		return 0, errors.New("pattern can never match")
+	}
+	return 1 / probability, nil
+}
+
+// Returns the Base58 characters matching c, both cases if caseInsensitive.
+func caseVariants(c byte, caseInsensitive bool) []byte {
+	candidates := []byte{c}
+	if caseInsensitive {
+		lower, upper := strings.ToLower(string(c))[0], strings.ToUpper(string(c))[0]
+		candidates = []byte{lower}
+		if upper != lower {
+			candidates = append(candidates, upper)
+		}
+	}
+	variants := []byte{}
+	for _, candidate := range candidates {
+		if base58.IsValidChar(candidate) {
+			variants = append(variants, candidate)
+		}
+	}
+	return variants
+}
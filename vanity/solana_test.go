@@ -0,0 +1,117 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+func TestSearch_Solana(t *testing.T) {
+	tests := []struct {
+		name            string
+		prefix          string
+		suffix          string
+		caseInsensitive bool
+	}{
+		{"prefix", "A", "", false},
+		{"suffix", "", "z", false},
+		{"case_insensitive", "", "lo", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := NewSolanaMatcher(tt.prefix, tt.suffix, tt.caseInsensitive)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			results, err := Search(context.Background(), NewSolanaGenerator(), matcher, &Options{Workers: 2})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			result := results[0]
+			if !matcher.Match(result.Address) {
+				t.Errorf("address does not match pattern %s", result.Address)
+			}
+			data, err := SolanaKeypairJSON(result)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			account, err := keymngr.ParseSolanaKeypairJSON(data)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if account.Address() != result.Address {
+				t.Errorf("keypair does not match address. expected %s actual %s", result.Address, account.Address())
+			}
+		})
+	}
+}
+
+func TestNewSolanaMatcher(t *testing.T) {
+	tests := []struct {
+		name            string
+		prefix          string
+		suffix          string
+		caseInsensitive bool
+		difficulty      float64
+	}{
+		{"suffix", "", "ab", false, 58 * 58},
+		{"suffix_case_insensitive", "", "ab", true, 29 * 29},
+		{"suffix_case_insensitive_single_form", "", "l1", true, 58 * 58},
+		{"leading_zero_byte", "1", "", false, 256},
+		{"prefix", "So1", "", false, 0},
+		{"zero", "0", "", false, -1},
+		{"upper_o", "", "O", false, -1},
+		{"upper_i", "I", "", false, -1},
+		{"lower_l", "l", "", false, -1},
+		{"zero_case_insensitive", "0", "", true, -1},
+		{"empty", "", "", false, -1},
+		{"too_long", strings.Repeat("1", 33), "", false, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := NewSolanaMatcher(tt.prefix, tt.suffix, tt.caseInsensitive)
+			if tt.difficulty < 0 {
+				if err == nil {
+					t.Errorf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if tt.difficulty > 0 && math.Abs(matcher.Difficulty()-tt.difficulty)/tt.difficulty > 1e-9 {
+				t.Errorf("invalid difficulty. expected %f actual %f", tt.difficulty, matcher.Difficulty())
+			}
+			if tt.difficulty == 0 && (matcher.Difficulty() < 58*58 || matcher.Difficulty() > 58*58*58*58) {
+				t.Errorf("invalid difficulty %f", matcher.Difficulty())
+			}
+		})
+	}
+}
+
+func TestSolanaGenerator_IndependentSeeds(t *testing.T) {
+	generator, err := NewSolanaGenerator()()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	generator.Next()
+	previous := new(big.Int).SetBytes(generator.Result().Key)
+	for i := 0; i < 16; i++ {
+		generator.Next()
+		current := new(big.Int).SetBytes(generator.Result().Key)
+		if distance := new(big.Int).Sub(current, previous); distance.CmpAbs(big.NewInt(1<<32)) < 0 {
+			t.Fatalf("seeds of consecutive candidates are related")
+		}
+		previous = current
+	}
+}
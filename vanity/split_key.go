@@ -0,0 +1,107 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+
+	btcutil "github.com/FactomProject/btcutilecc"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+// splitKeyGenerator walks consecutive offsets k from a random starting point and
+// produces the address of P + k*G where P is the public key of the requester.
+// The generator never sees the private key of P, so the private key of the final
+// address can only be computed by the requester.
+type splitKeyGenerator struct {
+	offset  *big.Int
+	x       *big.Int
+	y       *big.Int
+	address []byte
+	pubkey  []byte
+}
+
+// Returns a GeneratorFactory searching for offsets to add to the private key of publicKey.
+// publicKey can be in compressed or uncompressed format. Result.Key is the offset which must
+// be combined with the private key using CombineSplitKey to spend from Result.Address.
+func NewSplitKeyGenerator(publicKey stdx.Bytes) (GeneratorFactory, error) {
+	px, py, err := keymngr.ParsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return func() (Generator, error) {
+		offset, err := randomScalar()
+		if err != nil {
+			return nil, err
+		}
+		curve := btcutil.Secp256k1()
+		// Step back once so the first call to Next returns the random starting offset.
+		offset.Sub(offset, big.NewInt(1))
+		g := &splitKeyGenerator{
+			offset: offset,
+			pubkey: make([]byte, 64),
+		}
+		g.x, g.y = curve.ScalarBaseMult(offset.Bytes())
+		g.x, g.y = curve.Add(g.x, g.y, px, py)
+		return g, nil
+	}, nil
+}
+
+func (g *splitKeyGenerator) Next() string {
+	curve := btcutil.Secp256k1()
+	params := curve.Params()
+	g.offset.Add(g.offset, big.NewInt(1))
+	g.offset.Mod(g.offset, params.N)
+	g.x, g.y = curve.Add(g.x, g.y, params.Gx, params.Gy)
+	g.address = ethereumAddress(g.x, g.y, g.pubkey)
+	return hex.EncodeToString(g.address)
+}
+
+func (g *splitKeyGenerator) Result() *Result {
+	offset := make([]byte, 32)
+	g.offset.FillBytes(offset)
+	address, _ := keymngr.CreateChecksumAddress(hex.EncodeToString(g.address), nil)
+	return &Result{
+		Address: "0x" + address,
+		Key:     stdx.Bytes(offset),
+	}
+}
+
+// Returns the private key of the address found by a split-key search: (privateKey + offset) mod n.
+func CombineSplitKey(privateKey, offset stdx.Bytes) (stdx.Bytes, error) {
+	n := btcutil.Secp256k1().Params().N
+	sum := new(big.Int).SetBytes(privateKey)
+	sum.Add(sum, new(big.Int).SetBytes(offset))
+	sum.Mod(sum, n)
+	if sum.Sign() == 0 {
+		return nil, errors.New("combined private key is zero")
+	}
+	combined := make([]byte, 32)
+	sum.FillBytes(combined)
+	return stdx.Bytes(combined), nil
+}
+
+// Returns the EIP-55 address of publicKey + offset*G with 0x prefix.
+// It allows to verify a split-key result without knowing the private key.
+func SplitKeyAddress(publicKey, offset stdx.Bytes) (string, error) {
+	px, py, err := keymngr.ParsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	curve := btcutil.Secp256k1()
+	k := new(big.Int).SetBytes(offset)
+	if k.Sign() == 0 || k.Cmp(curve.Params().N) >= 0 {
+		return "", errors.New("offset must be in range [1, n-1]")
+	}
+	x, y := curve.ScalarBaseMult(offset)
+	x, y = curve.Add(x, y, px, py)
+	address, _ := keymngr.CreateChecksumAddress(hex.EncodeToString(ethereumAddress(x, y, make([]byte, 64))), nil)
+	return "0x" + address, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"strings"
+
+	"github.com/lukaz17/cryptotool-go/base58"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+// tronGenerator walks private keys like ethereumGenerator and encodes
+// the Ethereum address with the Tron version byte using Base58Check.
+type tronGenerator struct {
+	*ethereumGenerator
+	payload []byte
+	address string
+}
+
+// Returns a GeneratorFactory for Tron addresses. Each worker starts from a random private key
+// read from crypto/rand and jumps to a new one after every result.
+func NewTronGenerator() GeneratorFactory {
+	return func() (Generator, error) {
+		ethereum, err := newEthereumGenerator()
+		if err != nil {
+			return nil, err
+		}
+		payload := make([]byte, keymngr.TronAddressLength)
+		payload[0] = keymngr.TronAddressVersion
+		return &tronGenerator{
+			ethereumGenerator: ethereum,
+			payload:           payload,
+		}, nil
+	}
+}
+
+func (g *tronGenerator) Next() string {
+	if g.ethereumGenerator.Next() == "" {
+		return ""
+	}
+	copy(g.payload[1:], g.ethereumGenerator.address)
+	g.address = base58.CheckEncode(g.payload)
+	return g.address
+}
+
+func (g *tronGenerator) Result() *Result {
+	return &Result{
+		Address: g.address,
+		Key:     g.emit(),
+	}
+}
+
+// A TronMatcher matches Base58Check Tron addresses by prefix and suffix, case-sensitive.
+type TronMatcher struct {
+	prefix     string
+	suffix     string
+	difficulty float64
+}
+
+// Returns a TronMatcher for addresses starting with prefix and ending with suffix.
+// Every Tron address starts with T, which is assumed if prefix is empty.
+// Patterns with characters outside Base58, which exclude 0, O, I and l, or which can never match are rejected.
+func NewTronMatcher(prefix, suffix string) (*TronMatcher, error) {
+	if prefix == "" {
+		prefix = "T"
+	}
+	difficulty, err := base58Difficulty(keymngr.TronAddressVersion, prefix, suffix)
+	if err != nil {
+		return nil, err
+	}
+	return &TronMatcher{
+		prefix:     prefix,
+		suffix:     suffix,
+		difficulty: difficulty,
+	}, nil
+}
+
+// Returns true if address starts with prefix and ends with suffix.
+func (m *TronMatcher) Match(address string) bool {
+	return strings.HasPrefix(address, m.prefix) && strings.HasSuffix(address, m.suffix)
+}
+
+// Returns expected attempts to find one match.
+func (m *TronMatcher) Difficulty() float64 {
+	return m.difficulty
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vanity
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+func TestSearch_Tron(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		suffix string
+	}{
+		{"prefix", "TA", ""},
+		{"suffix", "", "z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := NewTronMatcher(tt.prefix, tt.suffix)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			results, err := Search(context.Background(), NewTronGenerator(), matcher, &Options{Workers: 2})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			result := results[0]
+			account := keymngr.NewTronAccount(keymngr.NewSecp256k1Keypair(result.Key))
+			if account.AddressStr() != result.Address {
+				t.Errorf("private key does not match address. expected %s actual %s", result.Address, account.AddressStr())
+			}
+			if !matcher.Match(result.Address) || !keymngr.IsValidTronAddress(result.Address) {
+				t.Errorf("address does not match pattern %s", result.Address)
+			}
+		})
+	}
+}
+
+func TestNewTronMatcher_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		suffix string
+	}{
+		{"wrong_version", "A", ""},
+		{"zero", "T0", ""},
+		{"lower_l", "T", "l"},
+		{"too_long", "T" + strings.Repeat("z", 40), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTronMatcher(tt.prefix, tt.suffix); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
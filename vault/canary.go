@@ -0,0 +1,187 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vault
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/lukaz17/cryptotool-go/keymngr"
+)
+
+const (
+	// BIP-44 account index reserved for canaries. Wallets scan accounts in order and stop at
+	// the first unused one, so canaries are never shown next to real accounts. An attacker
+	// importing the mnemonic in such a wallet does not see them either, that is why WatchList
+	// also includes the guarded addresses.
+	CanaryAccount = 999
+
+	CanaryTag = "canary"
+)
+
+const (
+	CanaryReason      = "canary"
+	CompromisedReason = "compromised"
+	GuardedReason     = "guarded"
+)
+
+// A WatchItem is an address an external monitor must watch. Any outgoing transaction of
+// a canary means that the secrets it guards are leaked. An unexpected outgoing transaction
+// of a guarded address means the same, even if its canaries are untouched.
+type WatchItem struct {
+	Label     string      `json:"label"`
+	Type      AccountType `json:"type"`
+	Network   string      `json:"network,omitempty"`
+	Address   string      `json:"address"`
+	Reason    string      `json:"reason"`
+	Guards    []string    `json:"guards,omitempty"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+// Returns the derivation path of canary index for accounts of accountType.
+// It follows DefaultDerivationPath with account CanaryAccount, e.g. m/44'/60'/999'/0/index.
+func CanaryDerivationPath(accountType AccountType, network string, addressType keymngr.BitcoinAddressType, index uint32) string {
+	return derivationPath(accountType, network, addressType, CanaryAccount, index)
+}
+
+// Adds a canary derived from the mnemonic of the entry whose ID, label or address is ref.
+// The canary has the same account type, mnemonic and passphrase at CanaryDerivationPath of index
+// and guards every entry sharing the mnemonic and passphrase. If label is empty,
+// the label of the canary is the label of the entry with suffix -canary-index.
+// Fund canaries with a small amount so an attacker scanning deeper accounts, as sweeping tools do,
+// is tempted to move it.
+func (v *Vault) AddCanary(ref string, index uint32, label string) (*Entry, error) {
+	entry, err := v.Get(ref)
+	if err != nil {
+		return nil, err
+	}
+	if len(entry.Mnemonic) == 0 {
+		return nil, errors.New("canaries can only be derived from mnemonic")
+	}
+	if entry.IsCanary() {
+		return nil, errors.New("canaries cannot be guarded by other canaries")
+	}
+	addressType, _ := keymngr.ParseBitcoinAddressType(entry.AddressType)
+	if label == "" {
+		label = fmt.Sprintf("%s-canary-%d", entry.Label, index)
+	}
+	canary := &Entry{
+		Label:          label,
+		Type:           entry.Type,
+		Network:        entry.Network,
+		AddressType:    entry.AddressType,
+		Mnemonic:       entry.Mnemonic,
+		Passphrase:     entry.Passphrase,
+		DerivationPath: CanaryDerivationPath(entry.Type, entry.Network, addressType, index),
+		Tags:           []string{CanaryTag},
+	}
+	for _, e := range v.entries {
+		if sharesSecret(e, entry) && !e.IsCanary() {
+			canary.CanaryFor = append(canary.CanaryFor, e.ID)
+		}
+	}
+	if err := v.Add(canary); err != nil {
+		return nil, err
+	}
+	return canary, nil
+}
+
+// Returns canaries guarding the entry whose ID, label or address is ref.
+func (v *Vault) CanariesOf(ref string) ([]*Entry, error) {
+	entry, err := v.Get(ref)
+	if err != nil {
+		return nil, err
+	}
+	canaries := []*Entry{}
+	for _, e := range v.entries {
+		if containsFold(e.CanaryFor, entry.ID) {
+			canaries = append(canaries, e)
+		}
+	}
+	return canaries, nil
+}
+
+// Returns addresses to be watched: every canary, every entry guarded by a canary
+// and every compromised entry.
+func (v *Vault) WatchList() []*WatchItem {
+	guarded := map[string]bool{}
+	for _, entry := range v.entries {
+		if entry.IsCanary() {
+			for _, id := range entry.CanaryFor {
+				guarded[strings.ToLower(id)] = true
+			}
+		}
+	}
+	items := []*WatchItem{}
+	for _, entry := range v.entries {
+		item := &WatchItem{
+			Label:     entry.Label,
+			Type:      entry.Type,
+			Network:   entry.Network,
+			Address:   entry.Address,
+			CreatedAt: entry.CreatedAt,
+		}
+		switch {
+		case entry.IsCanary():
+			item.Reason = CanaryReason
+			for _, id := range entry.CanaryFor {
+				if guarded, err := v.Get(id); err == nil {
+					item.Guards = append(item.Guards, guarded.Label)
+				}
+			}
+		case entry.IsCompromised():
+			item.Reason = CompromisedReason
+		case guarded[strings.ToLower(entry.ID)]:
+			item.Reason = GuardedReason
+		default:
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// Writes items to w as a JSON array.
+func WriteWatchListJSON(w io.Writer, items []*WatchItem) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(items)
+}
+
+// Writes items to w as CSV with a header row. Guarded labels are separated by semicolons.
+func WriteWatchListCSV(w io.Writer, items []*WatchItem) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"label", "type", "network", "address", "reason", "guards", "created_at"})
+	for _, item := range items {
+		writer.Write([]string{item.Label, string(item.Type), item.Network, item.Address, item.Reason,
+			strings.Join(item.Guards, ";"), item.CreatedAt.Format(time.RFC3339)})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// Adds entry to canaries guarding the same secret.
+func (v *Vault) linkCanaries(entry *Entry) {
+	if len(entry.Mnemonic) == 0 || entry.IsCanary() {
+		return
+	}
+	for _, e := range v.entries {
+		if e.IsCanary() && sharesSecret(e, entry) && !containsFold(e.CanaryFor, entry.ID) {
+			e.CanaryFor = append(e.CanaryFor, entry.ID)
+		}
+	}
+}
+
+func sharesSecret(a, b *Entry) bool {
+	return len(a.Mnemonic) > 0 && bytes.Equal(a.Mnemonic, b.Mnemonic) && bytes.Equal(a.Passphrase, b.Passphrase)
+}
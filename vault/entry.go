@@ -0,0 +1,318 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vault
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	btcutil "github.com/FactomProject/btcutilecc"
+	"github.com/lukaz17/cryptotool-go/keymngr"
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tforce-io/tf-golib/stdx"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// An AccountType is the kind of account an entry derives.
+type AccountType string
+
+const (
+	EthereumAccount AccountType = "ethereum"
+	BitcoinAccount  AccountType = "bitcoin"
+)
+
+// An Entry is an account stored in the vault. Exactly one of Mnemonic or PrivateKey is set.
+// Mnemonic and Passphrase are UTF-8 bytes rather than strings so the vault can wipe them.
+// Address is computed by the vault when the entry is added.
+type Entry struct {
+	ID             string      `json:"id"`
+	Label          string      `json:"label"`
+	Type           AccountType `json:"type"`
+	Network        string      `json:"network,omitempty"`
+	AddressType    string      `json:"addressType,omitempty"`
+	Address        string      `json:"address"`
+	Mnemonic       stdx.Bytes  `json:"mnemonic,omitempty"`
+	Passphrase     stdx.Bytes  `json:"passphrase,omitempty"`
+	DerivationPath string      `json:"derivationPath,omitempty"`
+	PrivateKey     stdx.Bytes  `json:"privateKey,omitempty"`
+	Tags           []string    `json:"tags,omitempty"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	CompromisedAt  *time.Time  `json:"compromisedAt,omitempty"`
+	ReplacedBy     string      `json:"replacedBy,omitempty"`
+	CanaryFor      []string    `json:"canaryFor,omitempty"`
+}
+
+// Returns the keypair of the entry. Callers should Destroy the keypair after use.
+func (e *Entry) Keypair() (*keymngr.Secp256k1Keypair, error) {
+	if len(e.PrivateKey) > 0 {
+		return keymngr.NewSecp256k1KeypairWithMetadata(e.PrivateKey, "", e.DerivationPath), nil
+	}
+	if len(e.Mnemonic) == 0 {
+		return nil, errors.New("entry has no secret")
+	}
+	// keymngr only accepts strings, these short-lived copies cannot be wiped.
+	mnemonic := string(e.Mnemonic)
+	key, err := keymngr.DeriveKeyFromMnemonic(mnemonic, string(e.Passphrase), e.DerivationPath)
+	if err != nil {
+		return nil, err
+	}
+	defer keymngr.WipeKey(key)
+	return keymngr.NewSecp256k1KeypairWithMetadata(key.Key, mnemonic, e.DerivationPath), nil
+}
+
+// Returns true if the entry was marked as compromised.
+func (e *Entry) IsCompromised() bool {
+	return e.CompromisedAt != nil
+}
+
+// Returns true if the entry is a canary guarding other entries.
+func (e *Entry) IsCanary() bool {
+	return len(e.CanaryFor) > 0
+}
+
+// Returns true if the entry has tag, case-insensitive.
+func (e *Entry) HasTag(tag string) bool {
+	return containsFold(e.Tags, tag)
+}
+
+// Returns a copy of the entry without mnemonic, passphrase and private key.
+func (e *Entry) Redacted() *Entry {
+	redacted := *e
+	redacted.Mnemonic = nil
+	redacted.Passphrase = nil
+	redacted.PrivateKey = nil
+	redacted.Tags = append([]string{}, e.Tags...)
+	redacted.CanaryFor = append([]string(nil), e.CanaryFor...)
+	if e.CompromisedAt != nil {
+		compromisedAt := *e.CompromisedAt
+		redacted.CompromisedAt = &compromisedAt
+	}
+	return &redacted
+}
+
+// Returns the label, type and address of the entry, secrets are never printed.
+func (e *Entry) String() string {
+	return fmt.Sprintf("%s (%s %s)", e.Label, e.Type, e.Address)
+}
+
+// Returns the same value as String so secrets are also redacted when formatted with %#v.
+func (e *Entry) GoString() string {
+	return e.String()
+}
+
+// Wipes the mnemonic, passphrase and private key of the entry.
+func (e *Entry) wipe() {
+	secmem.Wipe(e.Mnemonic)
+	secmem.Wipe(e.Passphrase)
+	secmem.Wipe(e.PrivateKey)
+}
+
+// Fills default values of the entry, validates its secret and computes its address.
+func (e *Entry) normalize() error {
+	e.Label = strings.TrimSpace(e.Label)
+	if e.Label == "" {
+		return errors.New("label is required")
+	}
+	if e.Type == "" {
+		e.Type = EthereumAccount
+	}
+	if e.Type != EthereumAccount && e.Type != BitcoinAccount {
+		return fmt.Errorf("unsupported account type %s", e.Type)
+	}
+	var network *keymngr.BitcoinNetwork
+	var addressType keymngr.BitcoinAddressType
+	if e.Type == BitcoinAccount {
+		var err error
+		if e.Network == "" {
+			e.Network = keymngr.BitcoinMainnet.Name
+		}
+		if network, err = keymngr.ParseBitcoinNetwork(e.Network); err != nil {
+			return err
+		}
+		if e.AddressType == "" {
+			e.AddressType = keymngr.P2WPKH.String()
+		}
+		if addressType, err = keymngr.ParseBitcoinAddressType(e.AddressType); err != nil {
+			return err
+		}
+		e.Network, e.AddressType = network.Name, addressType.String()
+	} else if e.Network != "" || e.AddressType != "" {
+		return errors.New("network and address type are only supported by bitcoin accounts")
+	}
+
+	switch {
+	case len(e.PrivateKey) > 0 && len(e.Mnemonic) > 0:
+		return errors.New("entry must have either mnemonic or private key, not both")
+	case len(e.PrivateKey) > 0:
+		if len(e.Passphrase) > 0 {
+			return errors.New("passphrase is only supported with mnemonic")
+		}
+		// The vault wipes private keys on close, keep a copy so buffers of callers are left untouched.
+		e.PrivateKey = append(stdx.Bytes{}, e.PrivateKey...)
+		key := new(big.Int).SetBytes(e.PrivateKey)
+		if len(e.PrivateKey) != 32 || key.Sign() == 0 || key.Cmp(btcutil.Secp256k1().Params().N) >= 0 {
+			return errors.New("invalid private key")
+		}
+	case len(e.Mnemonic) > 0:
+		// The vault wipes secrets on close, keep copies so buffers of callers are left untouched.
+		e.Mnemonic = stdx.Bytes(bytes.Join(bytes.Fields(e.Mnemonic), []byte(" ")))
+		e.Passphrase = append(stdx.Bytes(nil), e.Passphrase...)
+		if !bip39.IsMnemonicValid(string(e.Mnemonic)) {
+			return errors.New("invalid mnemonic")
+		}
+		if e.DerivationPath == "" {
+			e.DerivationPath = DefaultDerivationPath(e.Type, e.Network, addressType)
+		}
+	default:
+		return errors.New("entry must have either mnemonic or private key")
+	}
+	if e.DerivationPath != "" {
+		if _, err := keymngr.ParseDerivationPath(e.DerivationPath); err != nil {
+			return err
+		}
+	}
+
+	keypair, err := e.Keypair()
+	if err != nil {
+		return err
+	}
+	defer keypair.Destroy()
+	if e.Type == BitcoinAccount {
+		e.Address = keymngr.NewBitcoinAccount(keypair, network).Address(addressType)
+	} else {
+		e.Address = keymngr.NewEthereumAccount(keypair).AddressStr()
+	}
+	tags := []string{}
+	for _, tag := range e.Tags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !containsFold(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	e.Tags = tags
+	return nil
+}
+
+// Returns the derivation path of the first account of accountType used by popular wallets:
+// BIP-44 for Ethereum and legacy Bitcoin, BIP-84 for native segwit and BIP-86 for taproot.
+func DefaultDerivationPath(accountType AccountType, network string, addressType keymngr.BitcoinAddressType) string {
+	return derivationPath(accountType, network, addressType, 0, 0)
+}
+
+// Returns the derivation path of address index of account following the scheme of DefaultDerivationPath.
+func derivationPath(accountType AccountType, network string, addressType keymngr.BitcoinAddressType, account, index uint32) string {
+	if accountType != BitcoinAccount {
+		return fmt.Sprintf("m/44'/60'/%d'/0/%d", account, index)
+	}
+	coinType := 0
+	if !strings.EqualFold(network, keymngr.BitcoinMainnet.Name) {
+		coinType = 1
+	}
+	purpose := 84
+	switch addressType {
+	case keymngr.P2PKH:
+		purpose = 44
+	case keymngr.P2TR:
+		purpose = 86
+	}
+	return fmt.Sprintf("m/%d'/%d'/%d'/0/%d", purpose, coinType, account, index)
+}
+
+type jsonEntry struct {
+	*entryAlias
+	Mnemonic   jsonSecret `json:"mnemonic,omitempty"`
+	Passphrase jsonSecret `json:"passphrase,omitempty"`
+	PrivateKey string     `json:"privateKey,omitempty"`
+}
+
+type entryAlias Entry
+
+// Returns JSON encoding of the entry with mnemonic and passphrase as strings and private key in hex.
+func (e *Entry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&jsonEntry{
+		entryAlias: (*entryAlias)(e),
+		Mnemonic:   jsonSecret(e.Mnemonic),
+		Passphrase: jsonSecret(e.Passphrase),
+		PrivateKey: e.PrivateKey.HexStr(),
+	})
+}
+
+// Parses JSON encoding produced by MarshalJSON.
+func (e *Entry) UnmarshalJSON(data []byte) error {
+	j := jsonEntry{entryAlias: (*entryAlias)(e)}
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	privateKey, err := hex.DecodeString(j.PrivateKey)
+	if err != nil {
+		return err
+	}
+	e.PrivateKey = privateKey
+	if len(privateKey) == 0 {
+		e.PrivateKey = nil
+	}
+	e.Mnemonic, e.Passphrase = stdx.Bytes(j.Mnemonic), stdx.Bytes(j.Passphrase)
+	return nil
+}
+
+// A jsonSecret is a UTF-8 secret encoded in JSON as a string. It is decoded to bytes
+// without an intermediate string so it can be wiped.
+type jsonSecret []byte
+
+// Returns the secret as a JSON string.
+func (s jsonSecret) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, len(s)+2)
+	data = append(data, '"')
+	for _, c := range s {
+		switch {
+		case c == '"' || c == '\\':
+			data = append(data, '\\', c)
+		case c < 0x20:
+			data = append(data, fmt.Sprintf("\\u%04x", c)...)
+		default:
+			data = append(data, c)
+		}
+	}
+	return append(data, '"'), nil
+}
+
+// Parses a JSON string. Secrets containing escape sequences are rare, they are decoded
+// through a string which cannot be wiped.
+func (s *jsonSecret) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("secret must be a string")
+	}
+	content := data[1 : len(data)-1]
+	if bytes.IndexByte(content, '\\') < 0 {
+		*s = append(jsonSecret(nil), content...)
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	*s = jsonSecret(str)
+	return nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
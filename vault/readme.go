@@ -0,0 +1,20 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+/*
+Package vault provides APIs to store many accounts in a single password-protected file.
+Each entry holds a mnemonic or raw private key along with the derivation path, label and tags
+of an Ethereum or Bitcoin account.
+
+The whole content of the vault is encrypted using package encryptor with Argon2id by default,
+so any modification of the file is detected when it is opened. Changes are written to a
+temporary file which replaces the vault atomically, so the vault is never left half written.
+
+Canaries are accounts derived from the mnemonic of stored entries at account CanaryAccount.
+They hold a small bait amount and are exported with compromised accounts by WatchList,
+so an external monitor can raise an alert as soon as a leaked mnemonic is used.
+*/
+package vault
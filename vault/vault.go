@@ -0,0 +1,401 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vault
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	btcutil "github.com/FactomProject/btcutilecc"
+	"github.com/lukaz17/cryptotool-go/encryptor"
+	"github.com/lukaz17/cryptotool-go/internal/fileutil"
+	"github.com/lukaz17/cryptotool-go/secmem"
+	"github.com/tforce-io/tf-golib/stdx"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	vaultVersion = 1
+	idLength     = 8
+)
+
+// ErrNotFound is returned when no entry matches a reference.
+var ErrNotFound = errors.New("entry not found")
+
+// Options contains the key derivation function used to encrypt a new vault.
+// If KDF is empty, Argon2id is used. If parameters are nil, recommended values are used.
+type Options struct {
+	KDF    string
+	Scrypt *encryptor.ScryptParams
+	Argon2 *encryptor.Argon2Params
+}
+
+// A Vault is a decrypted vault file. Modifications are kept in memory until Save is called.
+// A Vault is not safe for concurrent use.
+type Vault struct {
+	path      string
+	password  *secmem.Buffer
+	encrypted *encryptor.EncryptedData
+	entries   []*Entry
+}
+
+type vaultFile struct {
+	Version int                      `json:"version"`
+	Data    *encryptor.EncryptedData `json:"data"`
+}
+
+type vaultContent struct {
+	Version int      `json:"version"`
+	Entries []*Entry `json:"entries"`
+}
+
+// Returns a new empty vault written to path. Returns error if path already exists.
+func Create(path string, password []byte, options *Options) (*Vault, error) {
+	if len(password) == 0 {
+		return nil, errors.New("password is required")
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("%s already exists", path)
+	}
+	if options == nil {
+		options = &Options{}
+	}
+	var template *encryptor.EncryptedData
+	switch options.KDF {
+	case "", encryptor.Argon2idKDF:
+		params := options.Argon2
+		if params == nil {
+			params = encryptor.DefaultArgon2Params()
+		}
+		template = &encryptor.EncryptedData{KDF: encryptor.Argon2idKDF, Argon2: params}
+	case encryptor.ScryptKDF:
+		params := options.Scrypt
+		if params == nil {
+			params = encryptor.DefaultScryptParams()
+		}
+		template = &encryptor.EncryptedData{KDF: encryptor.ScryptKDF, Scrypt: params}
+	default:
+		return nil, fmt.Errorf("unsupported key derivation function %s", options.KDF)
+	}
+	v := &Vault{
+		path:      path,
+		password:  secmem.NewBufferFrom(password),
+		encrypted: template,
+		entries:   []*Entry{},
+	}
+	if err := v.Save(); err != nil {
+		v.Close()
+		return nil, err
+	}
+	return v, nil
+}
+
+// Returns the vault stored at path decrypted with password.
+// encryptor.ErrDecryption is returned if the password is wrong or the file was modified.
+func Open(path string, password []byte) (*Vault, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file vaultFile
+	if err := json.Unmarshal(data, &file); err != nil || file.Data == nil {
+		return nil, errors.New("invalid vault file")
+	}
+	if file.Version != vaultVersion {
+		return nil, fmt.Errorf("unsupported vault version %d", file.Version)
+	}
+	plaintext, err := encryptor.Decrypt(file.Data, password)
+	if err != nil {
+		return nil, err
+	}
+	defer secmem.Wipe(plaintext)
+	var content vaultContent
+	if err := json.Unmarshal(plaintext, &content); err != nil {
+		return nil, errors.New("invalid vault content")
+	}
+	if content.Version != file.Version {
+		// Only the version in encrypted content is authenticated.
+		return nil, encryptor.ErrDecryption
+	}
+	if content.Entries == nil {
+		content.Entries = []*Entry{}
+	}
+	return &Vault{
+		path:      path,
+		password:  secmem.NewBufferFrom(password),
+		encrypted: file.Data,
+		entries:   content.Entries,
+	}, nil
+}
+
+// Returns the path of the vault file.
+func (v *Vault) Path() string {
+	return v.path
+}
+
+// Returns all entries in the order they were added.
+func (v *Vault) Entries() []*Entry {
+	return append([]*Entry{}, v.entries...)
+}
+
+// Returns the entry whose ID, label or address is ref. Label and address are case-insensitive.
+func (v *Vault) Get(ref string) (*Entry, error) {
+	index := v.indexOf(ref)
+	if index < 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, ref)
+	}
+	return v.entries[index], nil
+}
+
+// Returns entries matching all terms of query separated by spaces. A term in format
+// tag:name matches entries having the tag, type:name matches entries of the account type,
+// status:compromised or status:active matches entries by compromised mark, other terms match a part of label, address, derivation path or tags, case-insensitive.
+// Empty query matches all entries.
+func (v *Vault) Search(query string) []*Entry {
+	terms := strings.Fields(strings.ToLower(query))
+	entries := []*Entry{}
+	for _, entry := range v.entries {
+		if matchAll(entry, terms) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Adds entry to the vault after validating its secret. ID, Address and CreatedAt are filled
+// by the vault and default values are set for empty fields. Labels must be unique.
+func (v *Vault) Add(entry *Entry) error {
+	if err := entry.normalize(); err != nil {
+		return err
+	}
+	for _, e := range v.entries {
+		if strings.EqualFold(e.Label, entry.Label) {
+			return fmt.Errorf("label %s is already used", entry.Label)
+		}
+		if e.Type == entry.Type && strings.EqualFold(e.Address, entry.Address) {
+			return fmt.Errorf("address %s is already stored as %s", entry.Address, e.Label)
+		}
+	}
+	id := make([]byte, idLength)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	entry.ID = hex.EncodeToString(id)
+	entry.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	v.linkCanaries(entry)
+	v.entries = append(v.entries, entry)
+	return nil
+}
+
+// Marks the entry whose ID, label or address is ref as compromised and adds a replacement
+// with the same account type, network, address type and derivation path but a new secret.
+// A new mnemonic has the same number of words, a new private key is random. Passphrase is
+// not reused. If label is empty, the label of the replacement is the old label with suffix -rotated.
+// Other entries sharing the mnemonic and passphrase are marked as compromised too, they are
+// listed by SharingSecret until they are rotated. Returns the replacement entry.
+func (v *Vault) Rotate(ref, label string) (*Entry, error) {
+	entry, err := v.Get(ref)
+	if err != nil {
+		return nil, err
+	}
+	if entry.ReplacedBy != "" {
+		return nil, fmt.Errorf("%s is already replaced by %s", entry.Label, entry.ReplacedBy)
+	}
+	if label == "" {
+		label = entry.Label + "-rotated"
+	}
+	replacement := &Entry{
+		Label:          label,
+		Type:           entry.Type,
+		Network:        entry.Network,
+		AddressType:    entry.AddressType,
+		DerivationPath: entry.DerivationPath,
+		Tags:           append([]string{}, entry.Tags...),
+	}
+	if len(entry.Mnemonic) > 0 {
+		words := len(bytes.Fields(entry.Mnemonic))
+		entropy, err := bip39.NewEntropy(words * 32 / 3)
+		if err != nil {
+			return nil, err
+		}
+		defer secmem.Wipe(entropy)
+		mnemonic, err := bip39.NewMnemonic(entropy)
+		if err != nil {
+			return nil, err
+		}
+		replacement.Mnemonic = stdx.Bytes(mnemonic)
+	} else {
+		privateKey := make([]byte, 32)
+		// Add keeps its own copy of the private key.
+		defer secmem.Wipe(privateKey)
+		for {
+			if _, err := rand.Read(privateKey); err != nil {
+				return nil, err
+			}
+			key := new(big.Int).SetBytes(privateKey)
+			if key.Sign() > 0 && key.Cmp(btcutil.Secp256k1().Params().N) < 0 {
+				break
+			}
+		}
+		replacement.PrivateKey = privateKey
+	}
+	if err := v.Add(replacement); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	entry.CompromisedAt = &now
+	entry.ReplacedBy = replacement.ID
+	for _, e := range v.sharingSecret(entry) {
+		if !e.IsCompromised() {
+			compromisedAt := now
+			e.CompromisedAt = &compromisedAt
+		}
+	}
+	return replacement, nil
+}
+
+// Returns entries derived from the same mnemonic and passphrase as the entry whose ID,
+// label or address is ref and not replaced yet. Canaries are excluded.
+func (v *Vault) SharingSecret(ref string) ([]*Entry, error) {
+	entry, err := v.Get(ref)
+	if err != nil {
+		return nil, err
+	}
+	return v.sharingSecret(entry), nil
+}
+
+func (v *Vault) sharingSecret(entry *Entry) []*Entry {
+	entries := []*Entry{}
+	for _, e := range v.entries {
+		if e != entry && sharesSecret(e, entry) && !e.IsCanary() && e.ReplacedBy == "" {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// Removes and returns the entry whose ID, label or address is ref. Secrets of the returned
+// entry are wiped.
+func (v *Vault) Remove(ref string) (*Entry, error) {
+	index := v.indexOf(ref)
+	if index < 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, ref)
+	}
+	entry := v.entries[index]
+	v.entries = append(v.entries[:index], v.entries[index+1:]...)
+	entry.wipe()
+	return entry, nil
+}
+
+// Writes entries referenced by refs, or all entries if refs is empty, to w in plaintext JSON
+// including their secrets. The output must be protected by callers.
+func (v *Vault) Export(w io.Writer, refs ...string) error {
+	entries := v.entries
+	if len(refs) > 0 {
+		entries = make([]*Entry, 0, len(refs))
+		for _, ref := range refs {
+			entry, err := v.Get(ref)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
+
+// Encrypts all entries with a new salt and nonce and replaces the vault file atomically.
+func (v *Vault) Save() error {
+	if v.password.IsDestroyed() {
+		return errors.New("vault is closed")
+	}
+	plaintext, err := json.Marshal(&vaultContent{
+		Version: vaultVersion,
+		Entries: v.entries,
+	})
+	if err != nil {
+		return err
+	}
+	defer secmem.Wipe(plaintext)
+	encrypted, err := encryptor.EncryptLike(plaintext, v.password.Bytes(), v.encrypted)
+	runtime.KeepAlive(v.password)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(&vaultFile{
+		Version: vaultVersion,
+		Data:    encrypted,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteFileAtomic(v.path, data); err != nil {
+		return err
+	}
+	v.encrypted = encrypted
+	return nil
+}
+
+// Wipes the password and private keys from memory. The vault must not be used after Close.
+func (v *Vault) Close() {
+	v.password.Destroy()
+	for _, entry := range v.entries {
+		entry.wipe()
+	}
+	v.entries = nil
+}
+
+func (v *Vault) indexOf(ref string) int {
+	for i, entry := range v.entries {
+		if entry.ID == ref {
+			return i
+		}
+	}
+	for i, entry := range v.entries {
+		if strings.EqualFold(entry.Label, ref) || strings.EqualFold(entry.Address, ref) {
+			return i
+		}
+	}
+	return -1
+}
+
+func matchAll(entry *Entry, terms []string) bool {
+	for _, term := range terms {
+		switch {
+		case strings.HasPrefix(term, "tag:"):
+			if !entry.HasTag(term[len("tag:"):]) {
+				return false
+			}
+		case term == "status:compromised" || term == "status:active":
+			if entry.IsCompromised() != (term == "status:compromised") {
+				return false
+			}
+		case strings.HasPrefix(term, "type:"):
+			if string(entry.Type) != term[len("type:"):] {
+				return false
+			}
+		default:
+			fields := append([]string{entry.Label, entry.Address, entry.DerivationPath}, entry.Tags...)
+			if !strings.Contains(strings.ToLower(strings.Join(fields, "\n")), term) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,430 @@
+// Copyright (C) 2025 Nguyen Nhat Tung
+//
+// CryptoTool is licensed under the MIT license.
+// You should receive a copy of MIT along with this software.
+// If not, see <https://opensource.org/license/mit>
+
+package vault
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lukaz17/cryptotool-go/encryptor"
+	"github.com/tforce-io/tf-golib/stdx"
+)
+
+const testMnemonic = "repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat repeat rescue"
+
+var testOptions = &Options{Argon2: &encryptor.Argon2Params{Time: 1, Memory: 1024, Threads: 1}}
+
+func TestVault_Add(t *testing.T) {
+	privateKey, _ := hex.DecodeString("6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355")
+	tests := []struct {
+		name    string
+		entry   *Entry
+		address string
+		isValid bool
+	}{
+		{"ethereum_mnemonic", &Entry{Label: "main", Mnemonic: stdx.Bytes(testMnemonic), DerivationPath: "m/44'/60'/0'/0/1"},
+			"0x3d2F2242a7B705E7865c38a68989A7cde6b6f8Ad", true},
+		{"ethereum_default_path", &Entry{Label: "main", Mnemonic: stdx.Bytes(testMnemonic)},
+			"0x114A781017506df34B3Ed4C0E6B438889a6Eb3F7", true},
+		{"ethereum_private_key", &Entry{Label: "main", PrivateKey: privateKey},
+			"0x114A781017506df34B3Ed4C0E6B438889a6Eb3F7", true},
+		{"bitcoin_private_key", &Entry{Label: "main", Type: BitcoinAccount, AddressType: "legacy", PrivateKey: privateKey},
+			"", true},
+		{"missing_label", &Entry{Mnemonic: stdx.Bytes(testMnemonic)}, "", false},
+		{"missing_secret", &Entry{Label: "main"}, "", false},
+		{"both_secrets", &Entry{Label: "main", Mnemonic: stdx.Bytes(testMnemonic), PrivateKey: privateKey}, "", false},
+		{"invalid_mnemonic", &Entry{Label: "main", Mnemonic: stdx.Bytes("repeat repeat repeat")}, "", false},
+		{"invalid_private_key", &Entry{Label: "main", PrivateKey: make([]byte, 32)}, "", false},
+		{"invalid_type", &Entry{Label: "main", Type: "dogecoin", Mnemonic: stdx.Bytes(testMnemonic)}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Create(filepath.Join(t.TempDir(), "vault.json"), []byte("password"), testOptions)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			defer v.Close()
+			err = v.Add(tt.entry)
+			if !tt.isValid {
+				if err == nil {
+					t.Errorf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if tt.address != "" && tt.entry.Address != tt.address {
+				t.Errorf("invalid address. expected %s actual %s", tt.address, tt.entry.Address)
+			}
+			if tt.entry.ID == "" || tt.entry.Address == "" || tt.entry.CreatedAt.IsZero() {
+				t.Errorf("entry is not filled %+v", tt.entry.Redacted())
+			}
+			if err := v.Add(&Entry{Label: "MAIN", Mnemonic: stdx.Bytes(testMnemonic), DerivationPath: "m/44'/60'/0'/0/2"}); err == nil {
+				t.Errorf("expected duplicated label error")
+			}
+		})
+	}
+}
+
+func TestVault_SaveOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vault.json")
+	v, err := Create(path, []byte("password"), testOptions)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	entries := []*Entry{
+		{Label: "main", Mnemonic: stdx.Bytes(testMnemonic), Tags: []string{"hot", "defi"}},
+		{Label: "cold", Mnemonic: stdx.Bytes(testMnemonic), DerivationPath: "m/44'/60'/0'/0/1", Tags: []string{"cold"}},
+		{Label: "btc", Type: BitcoinAccount, Mnemonic: stdx.Bytes(testMnemonic), Tags: []string{"cold"}},
+	}
+	for _, entry := range entries {
+		if err := v.Add(entry); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+	if err := v.Save(); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	v.Close()
+	data, _ := os.ReadFile(path)
+	if bytes.Contains(data, []byte("repeat")) || bytes.Contains(data, []byte("main")) {
+		t.Fatalf("vault file contains plaintext")
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0600 {
+		t.Errorf("invalid permission %v", info.Mode().Perm())
+	}
+
+	v, err = Open(path, []byte("password"))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	defer v.Close()
+	tests := []struct {
+		name   string
+		query  string
+		labels []string
+	}{
+		{"all", "", []string{"main", "cold", "btc"}},
+		{"tag", "tag:cold", []string{"cold", "btc"}},
+		{"type", "type:bitcoin", []string{"btc"}},
+		{"text", "DEF", []string{"main"}},
+		{"path", "m/84'", []string{"btc"}},
+		{"multiple_terms", "tag:cold type:ethereum", []string{"cold"}},
+		{"no_match", "tag:warm", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels := []string{}
+			for _, entry := range v.Search(tt.query) {
+				labels = append(labels, entry.Label)
+			}
+			if strings.Join(labels, ",") != strings.Join(tt.labels, ",") {
+				t.Errorf("invalid entries. expected %v actual %v", tt.labels, labels)
+			}
+		})
+	}
+
+	if _, err := v.Remove("cold"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := v.Get("cold"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found error")
+	}
+	var exported bytes.Buffer
+	if err := v.Export(&exported, "main"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var restored []*Entry
+	if err := json.Unmarshal(exported.Bytes(), &restored); err != nil || len(restored) != 1 || string(restored[0].Mnemonic) != testMnemonic {
+		t.Errorf("invalid export %s", exported.String())
+	}
+}
+
+func TestOpen_Tampered(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		tamper   func(file map[string]interface{})
+	}{
+		{"wrong_password", "wrong", func(file map[string]interface{}) {}},
+		{"tampered_ciphertext", "password", func(file map[string]interface{}) {
+			data := file["data"].(map[string]interface{})
+			ciphertext := []byte(data["ciphertext"].(string))
+			ciphertext[0] ^= 1
+			data["ciphertext"] = string(ciphertext)
+		}},
+		{"tampered_params", "password", func(file map[string]interface{}) {
+			file["data"].(map[string]interface{})["argon2"].(map[string]interface{})["time"] = 2
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "vault.json")
+			v, err := Create(path, []byte("password"), testOptions)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			v.Add(&Entry{Label: "main", Mnemonic: stdx.Bytes(testMnemonic)})
+			v.Save()
+			v.Close()
+			data, _ := os.ReadFile(path)
+			var file map[string]interface{}
+			json.Unmarshal(data, &file)
+			tt.tamper(file)
+			data, _ = json.Marshal(file)
+			os.WriteFile(path, data, 0600)
+			if _, err := Open(path, []byte(tt.password)); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
+
+func TestVault_Rotate(t *testing.T) {
+	privateKey, _ := hex.DecodeString("6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355")
+	tests := []struct {
+		name  string
+		entry *Entry
+	}{
+		{"mnemonic", &Entry{Label: "main", Mnemonic: stdx.Bytes(testMnemonic), DerivationPath: "m/44'/60'/0'/0/3", Tags: []string{"hot"}}},
+		{"private_key", &Entry{Label: "main", PrivateKey: privateKey, Tags: []string{"hot"}}},
+		{"bitcoin", &Entry{Label: "main", Type: BitcoinAccount, AddressType: "p2tr", Mnemonic: stdx.Bytes(testMnemonic), Tags: []string{"hot"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, _ := Create(filepath.Join(t.TempDir(), "vault.json"), []byte("password"), testOptions)
+			defer v.Close()
+			if err := v.Add(tt.entry); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			replacement, err := v.Rotate("main", "")
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !tt.entry.IsCompromised() || tt.entry.ReplacedBy != replacement.ID || replacement.IsCompromised() {
+				t.Errorf("entry is not marked as compromised")
+			}
+			if replacement.Label != "main-rotated" || replacement.Type != tt.entry.Type || replacement.AddressType != tt.entry.AddressType ||
+				replacement.DerivationPath != tt.entry.DerivationPath || !replacement.HasTag("hot") {
+				t.Errorf("invalid replacement %#v", replacement)
+			}
+			if replacement.Address == tt.entry.Address || (len(tt.entry.Mnemonic) > 0 && bytes.Equal(replacement.Mnemonic, tt.entry.Mnemonic)) ||
+				len(bytes.Fields(replacement.Mnemonic)) != len(bytes.Fields(tt.entry.Mnemonic)) || len(replacement.PrivateKey) != len(tt.entry.PrivateKey) {
+				t.Errorf("replacement must have a new secret of the same kind")
+			}
+			if labels := v.Search("status:compromised"); len(labels) != 1 || labels[0] != tt.entry {
+				t.Errorf("invalid search result")
+			}
+			if _, err := v.Rotate("main", "other"); err == nil {
+				t.Errorf("expected already replaced error")
+			}
+		})
+	}
+}
+
+func TestVault_RotateSharedSecret(t *testing.T) {
+	tests := []struct {
+		name       string
+		ref        string
+		shared     []string
+		compromise []string
+	}{
+		{"first", "main", []string{"savings"}, []string{"main", "savings"}},
+		{"second", "savings", []string{"main"}, []string{"main", "savings"}},
+		{"other_passphrase", "protected", []string{}, []string{"protected"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, _ := Create(filepath.Join(t.TempDir(), "vault.json"), []byte("password"), testOptions)
+			defer v.Close()
+			for _, entry := range []*Entry{
+				{Label: "main", Mnemonic: stdx.Bytes(testMnemonic), DerivationPath: "m/44'/60'/0'/0/0"},
+				{Label: "savings", Mnemonic: stdx.Bytes(testMnemonic), DerivationPath: "m/44'/60'/0'/0/1"},
+				{Label: "protected", Mnemonic: stdx.Bytes(testMnemonic), Passphrase: stdx.Bytes("passphrase")},
+			} {
+				if err := v.Add(entry); err != nil {
+					t.Fatalf("unexpected error %v", err)
+				}
+			}
+			if _, err := v.AddCanary("main", 0, ""); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if _, err := v.Rotate(tt.ref, ""); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			shared, err := v.SharingSecret(tt.ref)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			labels := []string{}
+			for _, entry := range shared {
+				labels = append(labels, entry.Label)
+			}
+			if strings.Join(labels, ",") != strings.Join(tt.shared, ",") {
+				t.Errorf("invalid shared entries. expected %v actual %v", tt.shared, labels)
+			}
+			labels = []string{}
+			for _, entry := range v.Search("status:compromised") {
+				labels = append(labels, entry.Label)
+			}
+			if strings.Join(labels, ",") != strings.Join(tt.compromise, ",") {
+				t.Errorf("invalid compromised entries. expected %v actual %v", tt.compromise, labels)
+			}
+			for _, entry := range shared {
+				if entry.ReplacedBy != "" {
+					t.Errorf("%s must not be replaced", entry.Label)
+				}
+				if _, err := v.Rotate(entry.Label, ""); err != nil {
+					t.Errorf("unexpected error %v", err)
+				}
+			}
+			if shared, _ := v.SharingSecret(tt.ref); len(shared) != 0 {
+				t.Errorf("shared entries must be rotated")
+			}
+		})
+	}
+}
+
+func TestVault_AddCanary(t *testing.T) {
+	privateKey, _ := hex.DecodeString("6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355")
+	tests := []struct {
+		name    string
+		entry   *Entry
+		path    string
+		isValid bool
+	}{
+		{"ethereum", &Entry{Label: "main", Mnemonic: stdx.Bytes(testMnemonic)}, "m/44'/60'/999'/0/0", true},
+		{"bitcoin_testnet", &Entry{Label: "main", Type: BitcoinAccount, Network: "testnet", Mnemonic: stdx.Bytes(testMnemonic)}, "m/84'/1'/999'/0/0", true},
+		{"private_key", &Entry{Label: "main", PrivateKey: privateKey}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, _ := Create(filepath.Join(t.TempDir(), "vault.json"), []byte("password"), testOptions)
+			defer v.Close()
+			if err := v.Add(tt.entry); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			canary, err := v.AddCanary("main", 0, "")
+			if !tt.isValid {
+				if err == nil {
+					t.Errorf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if canary.DerivationPath != tt.path || canary.Label != "main-canary-0" || !canary.HasTag(CanaryTag) {
+				t.Errorf("invalid canary %#v %s", canary, canary.DerivationPath)
+			}
+			sibling := &Entry{Label: "second", Type: tt.entry.Type, Network: tt.entry.Network, Mnemonic: stdx.Bytes(testMnemonic),
+				DerivationPath: strings.Replace(tt.entry.DerivationPath, "/0/0", "/0/1", 1)}
+			if err := v.Add(sibling); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			for _, ref := range []string{"main", "second"} {
+				if canaries, _ := v.CanariesOf(ref); len(canaries) != 1 || canaries[0] != canary {
+					t.Errorf("canary does not guard %s", ref)
+				}
+			}
+			if _, err := v.AddCanary("main", 0, "duplicated"); err == nil {
+				t.Errorf("expected duplicated address error")
+			}
+
+			reasons := []string{}
+			for _, item := range v.WatchList() {
+				reasons = append(reasons, item.Label+":"+item.Reason)
+			}
+			if strings.Join(reasons, ",") != "main:guarded,main-canary-0:canary,second:guarded" {
+				t.Errorf("invalid watch list before rotation %v", reasons)
+			}
+
+			v.Rotate("second", "")
+			var jsonOutput, csvOutput bytes.Buffer
+			items := v.WatchList()
+			WriteWatchListJSON(&jsonOutput, items)
+			WriteWatchListCSV(&csvOutput, items)
+			if len(items) != 3 || items[0].Reason != CompromisedReason || items[0].Address != tt.entry.Address ||
+				items[1].Reason != CanaryReason || strings.Join(items[1].Guards, ",") != "main,second" ||
+				items[2].Reason != CompromisedReason || items[2].Address != sibling.Address {
+				t.Errorf("invalid watch list %s", jsonOutput.String())
+			}
+			lines := strings.Split(strings.TrimSpace(csvOutput.String()), "\n")
+			if len(lines) != 4 || !strings.Contains(lines[2], canary.Address+",canary,main;second") {
+				t.Errorf("invalid csv %s", csvOutput.String())
+			}
+			if strings.Contains(jsonOutput.String()+csvOutput.String(), "repeat") {
+				t.Errorf("watch list contains secret")
+			}
+		})
+	}
+}
+
+func TestEntry_JSON(t *testing.T) {
+	privateKey, _ := hex.DecodeString("6f210f99b79bd5d2d4d93c061aae0351aa00b2b9f1e5f43ffac58ac4a983d355")
+	tests := []struct {
+		name  string
+		entry *Entry
+	}{
+		{"mnemonic", &Entry{Label: "main", Mnemonic: stdx.Bytes(testMnemonic)}},
+		{"escaped_passphrase", &Entry{Label: "main", Mnemonic: stdx.Bytes(testMnemonic), Passphrase: stdx.Bytes("a \"quoted\" \\ <pass>\nphrase ü")}},
+		{"private_key", &Entry{Label: "main", PrivateKey: privateKey}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.entry)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			var restored Entry
+			if err := json.Unmarshal(data, &restored); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !bytes.Equal(restored.Mnemonic, tt.entry.Mnemonic) || !bytes.Equal(restored.Passphrase, tt.entry.Passphrase) ||
+				!bytes.Equal(restored.PrivateKey, tt.entry.PrivateKey) {
+				t.Errorf("invalid restored entry %s", data)
+			}
+			if len(tt.entry.Mnemonic) > 0 && !strings.Contains(string(data), `"mnemonic":"`+testMnemonic+`"`) {
+				t.Errorf("mnemonic must be encoded as string %s", data)
+			}
+		})
+	}
+}
+
+func TestVault_WipeSecrets(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove bool
+	}{
+		{"close", false},
+		{"remove", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, _ := Create(filepath.Join(t.TempDir(), "vault.json"), []byte("password"), testOptions)
+			entry := &Entry{Label: "main", Mnemonic: stdx.Bytes(testMnemonic), Passphrase: stdx.Bytes("passphrase")}
+			if err := v.Add(entry); err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			mnemonic, passphrase := entry.Mnemonic, entry.Passphrase
+			if tt.remove {
+				v.Remove("main")
+			}
+			v.Close()
+			if !bytes.Equal(mnemonic, make([]byte, len(mnemonic))) || !bytes.Equal(passphrase, make([]byte, len(passphrase))) {
+				t.Errorf("secrets are not wiped")
+			}
+		})
+	}
+}